@@ -1,22 +1,31 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/gocql/gocql"
 	"github.com/spf13/viper"
 	ErrorReporter "github.com/zytell3301/tg-error-reporter"
+	"github.com/zytell3301/tg-users-service/internal/codeSender"
 	core2 "github.com/zytell3301/tg-users-service/internal/core"
 	"github.com/zytell3301/tg-users-service/internal/errorReporter"
+	"github.com/zytell3301/tg-users-service/internal/eventPublisher"
 	"github.com/zytell3301/tg-users-service/internal/handlers/grpcHandlers"
+	"github.com/zytell3301/tg-users-service/internal/mailSender"
 	"github.com/zytell3301/tg-users-service/internal/repository"
+	"github.com/zytell3301/tg-users-service/internal/searchIndex"
 	"github.com/zytell3301/tg-users-service/pkg/CertGen"
 	"github.com/zytell3301/tg-users-service/pkg/UsersService"
 	uuid_generator "github.com/zytell3301/uuid-generator"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"io"
 	"log"
 	"net"
 	"os"
+	"strings"
+	"time"
 )
 
 const ProjectRoot = "."
@@ -24,6 +33,7 @@ const ProjectRoot = "."
 type configs struct {
 	repositoryConfigs repository.Configs
 	serviceConfigs    serviceConfigs
+	coreConfigs       core2.Configs
 }
 
 type serviceConfigs struct {
@@ -32,20 +42,81 @@ type serviceConfigs struct {
 	uuidSpace   string
 	serviceId   string
 	instanceId  string
+	codeSender  codeSenderConfigs
+	mailSender  mailSenderConfigs
+	// Type of the search index. It can be CASSANDRA or MEMORY
+	searchIndexType string
+	eventPublisher  eventPublisherConfigs
+	tls             tlsConfigs
+	// Interval of executing due account deletions
+	accountDeletionInterval time.Duration
+	accountTTL              accountTTLConfigs
+	// Interval of setting users without a recent heartbeat offline
+	presenceSweepInterval time.Duration
+}
+
+type mailSenderConfigs struct {
+	senderType string
+	filePath   string
+	smtp       mailSender.SmtpConfigs
+	templates  mailSender.Templates
+}
+
+type eventPublisherConfigs struct {
+	publisherType string
+	filePath      string
+	webhook       eventPublisher.WebhookConfigs
+}
+
+type tlsConfigs struct {
+	enabled     bool
+	certificate string
+	key         string
+	clientAuth  tls.ClientAuthType
+	// Path of PEM encoded certificate of the CA that issues admin certificates of other services
+	adminCA string
+	// Path of PEM or DER encoded revocation list of admin CA
+	adminRevocationList string
+	// Common names of client certificates of other services that are allowed to call admin rpcs
+	adminIdentities []string
+}
+
+type accountTTLConfigs struct {
+	sweepInterval time.Duration
+	// Only reports inactive accounts without deleting them
+	dryRun bool
+}
+
+type codeSenderConfigs struct {
+	senderType string
+	filePath   string
+	webhook    codeSender.WebhookConfigs
+	templates  codeSender.Templates
 }
 
 func main() {
 	configs := configs{}
 	configs.repositoryConfigs = loadRepositoryConfigs()
 	configs.serviceConfigs = loadServiceConfigs()
+	configs.coreConfigs = loadCoreConfigs()
 	errorReporter.InitiateReporter(configs.serviceConfigs.instanceId, configs.serviceConfigs.serviceId, ErrorReporter.DefaultReporter{})
 	uuidGenerator := newUuidGenerator(configs.serviceConfigs.uuidSpace)
 	repo := newUsersRepo(configs.repositoryConfigs, uuidGenerator)
 	certGen := newCertgen()
-	usersCore := core2.NewUsersCore(repo, certGen)
+	sender := newCodeSender(configs.serviceConfigs.codeSender)
+	mails := newMailSender(configs.serviceConfigs.mailSender)
+	index := newSearchIndex(configs.serviceConfigs.searchIndexType, repo)
+	publisher := newEventPublisher(configs.serviceConfigs.eventPublisher)
+	usersCore := core2.NewUsersCore(configs.coreConfigs, repo, index, certGen, sender, mails, publisher)
+	runAccountDeletionWorker(usersCore, configs.coreConfigs.AccountDeletionGracePeriod, configs.serviceConfigs.accountDeletionInterval)
+	runInactiveAccountsWorker(usersCore, configs.serviceConfigs.accountTTL)
+	runPresenceWorker(usersCore, configs.serviceConfigs.presenceSweepInterval)
 	grpcHandler := grpcHandlers.NewHandler(usersCore)
 	listener := newListener(configs)
-	grpcServer := grpc.NewServer()
+	adminCA := loadAdminCA(configs.serviceConfigs.tls, certGen)
+	admins := newAdmins(configs.serviceConfigs.tls, adminCA)
+	grpcServer := newGrpcServer(configs.serviceConfigs.tls, newClientCAs(certGen, adminCA), grpcHandlers.NewAuthInterceptor(usersCore, admins),
+		grpcHandlers.NewAuthStreamInterceptor(usersCore, admins))
 	UsersService.RegisterUsersServiceServer(grpcServer, grpcHandler)
 	fmt.Println("Serving grpc server")
 	err := grpcServer.Serve(listener)
@@ -101,6 +172,226 @@ func newCertgen() CertGen.CertGen {
 	return certGen
 }
 
+func newCodeSender(configs codeSenderConfigs) core2.CodeSender {
+	fmt.Println("Creating code sender instance...")
+	switch configs.senderType {
+	case "FILE":
+		fmt.Println("File code sender created successfully. File code sender MUST NOT be used in production")
+		return codeSender.NewFileSender(configs.filePath, configs.templates)
+	case "WEBHOOK":
+		fmt.Println("Webhook code sender created successfully")
+		return codeSender.NewWebhookSender(configs.webhook, configs.templates)
+	default:
+		panic(fmt.Sprintf("Defined code sender type is not valid. Expected: FILE,WEBHOOK, got: %v", configs.senderType))
+	}
+}
+
+/**
+ * Cassandra search index is implemented by the repository
+ */
+func newSearchIndex(indexType string, repo repository.Repository) core2.SearchIndex {
+	fmt.Println("Creating search index instance...")
+	switch indexType {
+	case "CASSANDRA":
+		fmt.Println("Cassandra search index created successfully")
+		return repo
+	case "MEMORY":
+		fmt.Println("Memory search index created successfully. Memory search index MUST NOT be used in production")
+		return searchIndex.NewMemoryIndex()
+	default:
+		panic(fmt.Sprintf("Defined search index type is not valid. Expected: CASSANDRA,MEMORY, got: %v", indexType))
+	}
+}
+
+func newMailSender(configs mailSenderConfigs) core2.MailSender {
+	fmt.Println("Creating mail sender instance...")
+	switch configs.senderType {
+	case "FILE":
+		fmt.Println("File mail sender created successfully. File mail sender MUST NOT be used in production")
+		return mailSender.NewFileSender(configs.filePath, configs.templates)
+	case "SMTP":
+		fmt.Println("Smtp mail sender created successfully")
+		return mailSender.NewSmtpSender(configs.smtp, configs.templates)
+	default:
+		panic(fmt.Sprintf("Defined mail sender type is not valid. Expected: FILE,SMTP, got: %v", configs.senderType))
+	}
+}
+
+func newEventPublisher(configs eventPublisherConfigs) core2.EventPublisher {
+	fmt.Println("Creating event publisher instance...")
+	switch configs.publisherType {
+	case "FILE":
+		fmt.Println("File event publisher created successfully. File event publisher MUST NOT be used in production")
+		return eventPublisher.NewFilePublisher(configs.filePath)
+	case "WEBHOOK":
+		fmt.Println("Webhook event publisher created successfully")
+		return eventPublisher.NewWebhookPublisher(configs.webhook)
+	default:
+		panic(fmt.Sprintf("Defined event publisher type is not valid. Expected: FILE,WEBHOOK, got: %v", configs.publisherType))
+	}
+}
+
+/**
+ * Executes due account deletions periodically. Worker is not started if accounts are deleted immediately
+ */
+func runAccountDeletionWorker(usersCore core2.Service, gracePeriod time.Duration, interval time.Duration) {
+	switch gracePeriod > 0 {
+	case false:
+		return
+	}
+	switch interval <= 0 {
+	case true:
+		panic(fmt.Sprintf("Account deletion interval must be a positive duration, got: %v", interval))
+	}
+	fmt.Println("Starting account deletion worker")
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			usersCore.DeleteDueAccounts()
+		}
+	}()
+}
+
+/**
+ * Deletes inactive accounts periodically and logs summary of each sweep. Worker is not started if sweep interval is not set
+ */
+func runInactiveAccountsWorker(usersCore core2.Service, configs accountTTLConfigs) {
+	switch configs.sweepInterval > 0 {
+	case false:
+		return
+	}
+	fmt.Println("Starting inactive accounts worker")
+	go func() {
+		ticker := time.NewTicker(configs.sweepInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			sweep := usersCore.DeleteInactiveAccounts(configs.dryRun)
+			log.Printf("Inactive accounts sweep finished. Dry run: %t, pages: %d, scanned: %d, expired: %d, deleted: %d, orphaned: %d, failed: %d, candidates: %v",
+				sweep.DryRun, sweep.Pages, sweep.Scanned, sweep.Expired, sweep.Deleted, sweep.Orphaned, sweep.Failed, sweep.Candidates)
+		}
+	}()
+}
+
+func runPresenceWorker(usersCore core2.Service, interval time.Duration) {
+	fmt.Println("Starting presence worker")
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			usersCore.ExpirePresences()
+		}
+	}()
+}
+
+/**
+ * Loads certificate of admin CA. Admin CA must not be the service root certificate, otherwise certificates of users
+ * could be taken as admin certificates. Nil is returned if admin CA is not defined
+ */
+func loadAdminCA(configs tlsConfigs, certGen CertGen.CertGen) *x509.Certificate {
+	switch configs.adminCA == "" {
+	case true:
+		switch len(configs.adminIdentities) != 0 {
+		case true:
+			panic("Admin CA must be defined when admin identities are defined")
+		}
+		return nil
+	}
+	fmt.Println("Loading admin CA certificate...")
+	content, err := os.ReadFile(configs.adminCA)
+	switch err != nil {
+	case true:
+		panic(fmt.Sprintf("An error occurred while reading admin CA certificate. Error message: %s", err.Error()))
+	}
+	block := CertGen.DecodePem(content)
+	switch block == nil {
+	case true:
+		panic("Admin CA certificate is not PEM encoded")
+	}
+	adminCA, err := x509.ParseCertificate(block.Bytes)
+	switch err != nil {
+	case true:
+		panic(fmt.Sprintf("An error occurred while parsing admin CA certificate. Error message: %s", err.Error()))
+	}
+	switch adminCA.Equal(certGen.CaCert) {
+	case true:
+		panic("Admin CA must not be the service root certificate")
+	}
+	fmt.Println("Admin CA certificate loaded successfully")
+	return adminCA
+}
+
+func newAdmins(configs tlsConfigs, adminCA *x509.Certificate) grpcHandlers.Admins {
+	var revocationList []byte
+	switch adminCA != nil && configs.adminRevocationList != "" {
+	case true:
+		fmt.Println("Loading admin CA revocation list...")
+		content, err := os.ReadFile(configs.adminRevocationList)
+		switch err != nil {
+		case true:
+			panic(fmt.Sprintf("An error occurred while reading admin CA revocation list. Error message: %s", err.Error()))
+		}
+		revocationList = content
+	}
+	admins, err := grpcHandlers.NewAdmins(adminCA, revocationList, configs.adminIdentities)
+	switch err != nil {
+	case true:
+		panic(fmt.Sprintf("An error occurred while loading admin CA revocation list. Error message: %s", err.Error()))
+	}
+	return admins
+}
+
+/**
+ * Returns the pool of CA certificates that client certificates are verified against by transport layer.
+ * It contains the service root certificate and admin CA if it is defined
+ */
+func newClientCAs(certGen CertGen.CertGen, adminCA *x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(certGen.CaCert)
+	switch adminCA != nil {
+	case true:
+		pool.AddCert(adminCA)
+	}
+	return pool
+}
+
+/**
+ * Creates grpc server. If tls is enabled, client certificates are verified against clientCAs
+ */
+func newGrpcServer(configs tlsConfigs, clientCAs *x509.CertPool, authInterceptor grpc.UnaryServerInterceptor, authStreamInterceptor grpc.StreamServerInterceptor) *grpc.Server {
+	switch configs.enabled {
+	case false:
+		fmt.Println("Tls is disabled. Grpc server MUST NOT be served without tls in production. Authenticated rpcs are not available")
+		return grpc.NewServer(grpc.UnaryInterceptor(authInterceptor), grpc.StreamInterceptor(authStreamInterceptor))
+	}
+	fmt.Println("Loading server certificate...")
+	certificate, err := tls.LoadX509KeyPair(configs.certificate, configs.key)
+	switch err != nil {
+	case true:
+		panic(fmt.Sprintf("An error occurred while loading server certificate. Error message: %s", err.Error()))
+	}
+	fmt.Println("Server certificate loaded successfully")
+	return grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   configs.clientAuth,
+		MinVersion:   tls.VersionTLS12,
+	})), grpc.UnaryInterceptor(authInterceptor), grpc.StreamInterceptor(authStreamInterceptor))
+}
+
+func parseClientAuth(clientAuth string) tls.ClientAuthType {
+	switch clientAuth {
+	case "NONE":
+		return tls.NoClientCert
+	case "OPTIONAL":
+		return tls.VerifyClientCertIfGiven
+	case "REQUIRED":
+		return tls.RequireAndVerifyClientCert
+	default:
+		panic(fmt.Sprintf("Defined tls client auth is not valid. Expected: NONE,OPTIONAL,REQUIRED, got: %v", clientAuth))
+	}
+}
+
 func newListener(configs configs) net.Listener {
 	listener, err := net.Listen("tcp", configs.serviceConfigs.nodeIp+":"+configs.serviceConfigs.servicePort)
 	switch err != nil {
@@ -118,13 +409,59 @@ func loadRepositoryConfigs() (config repository.Configs) {
 	consistencyLevels := cfg.GetStringMapString("consistency-levels")
 	config.ConsistencyLevels.NewUser = parseConsistencyLevel(consistencyLevels["new-user"])
 	config.ConsistencyLevels.GetUserByPhone = parseConsistencyLevel(consistencyLevels["get-user-by-phone"])
+	config.ConsistencyLevels.GetUserById = parseConsistencyLevel(consistencyLevels["get-user-by-id"])
 	config.ConsistencyLevels.GetSecurityCode = parseConsistencyLevel(consistencyLevels["get-security-code"])
 	config.ConsistencyLevels.GetUserByUsername = parseConsistencyLevel(consistencyLevels["get-user-by-username"])
 	config.ConsistencyLevels.DoesUserExists = parseConsistencyLevel(consistencyLevels["does-user-exists"])
 	config.ConsistencyLevels.RecordSecurityCode = parseConsistencyLevel(consistencyLevels["record-security-code"])
 	config.ConsistencyLevels.DeleteUser = parseConsistencyLevel(consistencyLevels["delete-user"])
 	config.ConsistencyLevels.UpdateUsername = parseConsistencyLevel(consistencyLevels["update-username"])
+	config.ConsistencyLevels.UpdateProfile = parseConsistencyLevel(consistencyLevels["update-profile"])
+	config.ConsistencyLevels.ChangePhone = parseConsistencyLevel(consistencyLevels["change-phone"])
 	config.ConsistencyLevels.DoesUsernameExists = parseConsistencyLevel(consistencyLevels["does-username-exists"])
+	config.ConsistencyLevels.IncrementSecurityCodeAttempts = parseConsistencyLevel(consistencyLevels["increment-security-code-attempts"])
+	config.ConsistencyLevels.DeleteSecurityCode = parseConsistencyLevel(consistencyLevels["delete-security-code"])
+	config.ConsistencyLevels.ConsumeSecurityCode = parseConsistencyLevel(consistencyLevels["consume-security-code"])
+	config.ConsistencyLevels.RecordSecurityCodeRequest = parseConsistencyLevel(consistencyLevels["record-security-code-request"])
+	config.ConsistencyLevels.GetSecurityCodeRequests = parseConsistencyLevel(consistencyLevels["get-security-code-requests"])
+	config.ConsistencyLevels.RecordCertificate = parseConsistencyLevel(consistencyLevels["record-certificate"])
+	config.ConsistencyLevels.GetUserCertificates = parseConsistencyLevel(consistencyLevels["get-user-certificates"])
+	config.ConsistencyLevels.RevokeCertificates = parseConsistencyLevel(consistencyLevels["revoke-certificates"])
+	config.ConsistencyLevels.IsCertificateRevoked = parseConsistencyLevel(consistencyLevels["is-certificate-revoked"])
+	config.ConsistencyLevels.GetRevokedCertificates = parseConsistencyLevel(consistencyLevels["get-revoked-certificates"])
+	config.ConsistencyLevels.NewSession = parseConsistencyLevel(consistencyLevels["new-session"])
+	config.ConsistencyLevels.GetUserSessions = parseConsistencyLevel(consistencyLevels["get-user-sessions"])
+	config.ConsistencyLevels.DeleteSessions = parseConsistencyLevel(consistencyLevels["delete-sessions"])
+	config.ConsistencyLevels.ScheduleAccountDeletion = parseConsistencyLevel(consistencyLevels["schedule-account-deletion"])
+	config.ConsistencyLevels.GetAccountDeletion = parseConsistencyLevel(consistencyLevels["get-account-deletion"])
+	config.ConsistencyLevels.CancelAccountDeletion = parseConsistencyLevel(consistencyLevels["cancel-account-deletion"])
+	config.ConsistencyLevels.GetDueAccountDeletions = parseConsistencyLevel(consistencyLevels["get-due-account-deletions"])
+	config.ConsistencyLevels.RecordAccountActivity = parseConsistencyLevel(consistencyLevels["record-account-activity"])
+	config.ConsistencyLevels.SetAccountTTL = parseConsistencyLevel(consistencyLevels["set-account-ttl"])
+	config.ConsistencyLevels.GetAccountTTL = parseConsistencyLevel(consistencyLevels["get-account-ttl"])
+	config.ConsistencyLevels.GetAccountActivities = parseConsistencyLevel(consistencyLevels["get-account-activities"])
+	config.ConsistencyLevels.DeleteAccountActivity = parseConsistencyLevel(consistencyLevels["delete-account-activity"])
+	config.ConsistencyLevels.SetPassword = parseConsistencyLevel(consistencyLevels["set-password"])
+	config.ConsistencyLevels.GetPassword = parseConsistencyLevel(consistencyLevels["get-password"])
+	config.ConsistencyLevels.DeletePassword = parseConsistencyLevel(consistencyLevels["delete-password"])
+	config.ConsistencyLevels.RecordPendingLogin = parseConsistencyLevel(consistencyLevels["record-pending-login"])
+	config.ConsistencyLevels.GetPendingLogin = parseConsistencyLevel(consistencyLevels["get-pending-login"])
+	config.ConsistencyLevels.DeletePendingLogin = parseConsistencyLevel(consistencyLevels["delete-pending-login"])
+	config.ConsistencyLevels.GetUsersByIds = parseConsistencyLevel(consistencyLevels["get-users-by-ids"])
+	config.ConsistencyLevels.IndexUser = parseConsistencyLevel(consistencyLevels["index-user"])
+	config.ConsistencyLevels.SearchUsers = parseConsistencyLevel(consistencyLevels["search-users"])
+	config.ConsistencyLevels.SetOnlineStatus = parseConsistencyLevel(consistencyLevels["set-online-status"])
+	config.ConsistencyLevels.ExpireOnlineStatus = parseConsistencyLevel(consistencyLevels["expire-online-status"])
+	config.ConsistencyLevels.SetPrivacyRule = parseConsistencyLevel(consistencyLevels["set-privacy-rule"])
+	config.ConsistencyLevels.GetPrivacyRules = parseConsistencyLevel(consistencyLevels["get-privacy-rules"])
+	config.ConsistencyLevels.GetUserIdsByPhones = parseConsistencyLevel(consistencyLevels["get-user-ids-by-phones"])
+	config.ConsistencyLevels.AddContacts = parseConsistencyLevel(consistencyLevels["add-contacts"])
+	config.ConsistencyLevels.GetContacts = parseConsistencyLevel(consistencyLevels["get-contacts"])
+	config.ConsistencyLevels.DeleteContacts = parseConsistencyLevel(consistencyLevels["delete-contacts"])
+	config.ConsistencyLevels.GetContactOwners = parseConsistencyLevel(consistencyLevels["get-contact-owners"])
+	config.ConsistencyLevels.GetReverseContacts = parseConsistencyLevel(consistencyLevels["get-reverse-contacts"])
+	config.ConsistencyLevels.RecordContactImports = parseConsistencyLevel(consistencyLevels["record-contact-imports"])
+	config.ConsistencyLevels.GetContactImports = parseConsistencyLevel(consistencyLevels["get-contact-imports"])
 	config.Port = cfg.GetInt("port")
 	fmt.Println("Repository config loaded successfully")
 	return
@@ -163,10 +500,122 @@ func loadServiceConfigs() (config serviceConfigs) {
 	config.uuidSpace = cfg.GetString("uuid-space")
 	config.serviceId = cfg.GetString("service-id")
 	config.instanceId = cfg.GetString("instance-id")
+	config.codeSender.senderType = cfg.GetString("code-sender.type")
+	config.codeSender.filePath = cfg.GetString("code-sender.file-path")
+	config.codeSender.webhook.Url = cfg.GetString("code-sender.webhook.url")
+	config.codeSender.webhook.Authorization = cfg.GetString("code-sender.webhook.authorization")
+	config.codeSender.webhook.Timeout = cfg.GetDuration("code-sender.webhook.timeout")
+	config.codeSender.templates = codeSender.Templates{}
+	for action, template := range cfg.GetStringMapString("code-sender.templates") {
+		config.codeSender.templates[strings.ToUpper(action)] = template
+	}
+	config.searchIndexType = cfg.GetString("search-index.type")
+	config.mailSender.senderType = cfg.GetString("mail-sender.type")
+	config.mailSender.filePath = cfg.GetString("mail-sender.file-path")
+	config.mailSender.smtp.Host = cfg.GetString("mail-sender.smtp.host")
+	config.mailSender.smtp.Port = cfg.GetString("mail-sender.smtp.port")
+	config.mailSender.smtp.Username = cfg.GetString("mail-sender.smtp.username")
+	config.mailSender.smtp.Password = cfg.GetString("mail-sender.smtp.password")
+	config.mailSender.smtp.From = cfg.GetString("mail-sender.smtp.from")
+	config.mailSender.templates = mailSender.Templates{}
+	for action := range cfg.GetStringMap("mail-sender.templates") {
+		config.mailSender.templates[strings.ToUpper(action)] = mailSender.Template{
+			Subject: cfg.GetString("mail-sender.templates." + action + ".subject"),
+			Body:    cfg.GetString("mail-sender.templates." + action + ".body"),
+		}
+	}
+	config.eventPublisher.publisherType = cfg.GetString("event-publisher.type")
+	config.eventPublisher.filePath = cfg.GetString("event-publisher.file-path")
+	config.eventPublisher.webhook.Url = cfg.GetString("event-publisher.webhook.url")
+	config.eventPublisher.webhook.Authorization = cfg.GetString("event-publisher.webhook.authorization")
+	config.eventPublisher.webhook.Timeout = cfg.GetDuration("event-publisher.webhook.timeout")
+	config.accountDeletionInterval = cfg.GetDuration("account-deletion.interval")
+	config.accountTTL.sweepInterval = cfg.GetDuration("account-ttl.sweep-interval")
+	config.accountTTL.dryRun = cfg.GetBool("account-ttl.dry-run")
+	config.presenceSweepInterval = cfg.GetDuration("presence.sweep-interval")
+	switch config.presenceSweepInterval < time.Second {
+	case true:
+		panic(fmt.Sprintf("Presence sweep interval must be at least 1s, got: %v", config.presenceSweepInterval))
+	}
+	config.tls.enabled = cfg.GetBool("tls.enabled")
+	config.tls.certificate = cfg.GetString("tls.certificate")
+	config.tls.key = cfg.GetString("tls.key")
+	config.tls.clientAuth = parseClientAuth(cfg.GetString("tls.client-auth"))
+	config.tls.adminCA = cfg.GetString("tls.admin-ca")
+	config.tls.adminRevocationList = cfg.GetString("tls.admin-crl")
+	config.tls.adminIdentities = cfg.GetStringSlice("tls.admin-identities")
 	fmt.Println("Service configs loaded successfully")
 	return
 }
 
+/**
+ * Core configs are defined in service config file
+ */
+func loadCoreConfigs() (config core2.Configs) {
+	fmt.Println("Loading core configs")
+	cfg := loadConfig("service")
+	config.SecurityCodeMaxAttempts = cfg.GetInt("security-code.max-attempts")
+	switch config.SecurityCodeMaxAttempts < 1 {
+	case true:
+		panic(fmt.Sprintf("Security code max attempts must be at least 1, got: %d", config.SecurityCodeMaxAttempts))
+	}
+	config.SecurityCodeResendCooldown = cfg.GetDuration("security-code.resend-cooldown")
+	config.SecurityCodePhoneRateLimit = loadRateLimit(cfg, "security-code.rate-limits.phone")
+	config.SecurityCodeIpRateLimit = loadRateLimit(cfg, "security-code.rate-limits.ip")
+	config.CertificateValidity = cfg.GetDuration("certificate.validity")
+	switch config.CertificateValidity <= 0 {
+	case true:
+		panic(fmt.Sprintf("Certificate validity must be a positive duration, got: %v", config.CertificateValidity))
+	}
+	config.RevocationListValidity = cfg.GetDuration("certificate.revocation-list-validity")
+	switch config.RevocationListValidity <= 0 {
+	case true:
+		panic(fmt.Sprintf("Revocation list validity must be a positive duration, got: %v", config.RevocationListValidity))
+	}
+	config.ChangePhoneTerminatesSessions = cfg.GetBool("change-phone.terminate-sessions")
+	config.AccountDeletionGracePeriod = cfg.GetDuration("account-deletion.grace-period")
+	config.DefaultAccountTTLDays = cfg.GetInt("account-ttl.default-days")
+	switch config.DefaultAccountTTLDays < 1 {
+	case true:
+		panic(fmt.Sprintf("Default account ttl must be at least 1 day, got: %d", config.DefaultAccountTTLDays))
+	}
+	config.AccountActivityInterval = cfg.GetDuration("account-ttl.activity-interval")
+	config.PasswordRateLimit = loadRateLimit(cfg, "password.rate-limit")
+	config.PendingLoginValidity = cfg.GetDuration("password.pending-login-validity")
+	switch config.PendingLoginValidity < time.Second {
+	case true:
+		panic(fmt.Sprintf("Pending login validity must be at least 1s, got: %v", config.PendingLoginValidity))
+	}
+	config.UsersBatchMaxSize = cfg.GetInt("users-lookup.max-batch-size")
+	switch config.UsersBatchMaxSize < 1 {
+	case true:
+		panic(fmt.Sprintf("Users lookup max batch size must be at least 1, got: %d", config.UsersBatchMaxSize))
+	}
+	config.PresenceTimeout = cfg.GetDuration("presence.timeout")
+	switch config.PresenceTimeout < time.Second {
+	case true:
+		panic(fmt.Sprintf("Presence timeout must be at least 1s, got: %v", config.PresenceTimeout))
+	}
+	config.ContactsImportMaxSize = cfg.GetInt("contacts.import-max-size")
+	switch config.ContactsImportMaxSize < 1 {
+	case true:
+		panic(fmt.Sprintf("Contacts import max size must be at least 1, got: %d", config.ContactsImportMaxSize))
+	}
+	config.ContactsImportRateLimit = loadRateLimit(cfg, "contacts.import-rate-limit")
+	fmt.Println("Core configs loaded successfully")
+	return
+}
+
+func loadRateLimit(cfg *viper.Viper, key string) (limit core2.RateLimit) {
+	limit.Requests = cfg.GetInt(key + ".requests")
+	limit.Window = cfg.GetDuration(key + ".window")
+	switch limit.Requests > 0 && limit.Window < time.Second {
+	case true:
+		panic(fmt.Sprintf("Rate limit window of %s must be at least 1s, got: %v", key, limit.Window))
+	}
+	return
+}
+
 func getCertificate() []byte {
 	fmt.Println("Service root certificate is being loaded")
 	file, err := os.Open("./auth-certificates/certificate.pem")
@@ -3,7 +3,7 @@ module github.com/zytell3301/tg-users-service
 go 1.16
 
 require (
-	bou.ke/monkey v1.0.2 // indirect
+	bou.ke/monkey v1.0.2
 	github.com/gocql/gocql v0.0.0-20220224095938-0eacd3183625
 	github.com/golang/mock v1.6.0
 	github.com/golang/snappy v0.0.4 // indirect
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../core/code_sender.go
+
+// Package codeSender is a generated GoMock package.
+package codeSender
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCodeSender is a mock of CodeSender interface.
+type MockCodeSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockCodeSenderMockRecorder
+}
+
+// MockCodeSenderMockRecorder is the mock recorder for MockCodeSender.
+type MockCodeSenderMockRecorder struct {
+	mock *MockCodeSender
+}
+
+// NewMockCodeSender creates a new mock instance.
+func NewMockCodeSender(ctrl *gomock.Controller) *MockCodeSender {
+	mock := &MockCodeSender{ctrl: ctrl}
+	mock.recorder = &MockCodeSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCodeSender) EXPECT() *MockCodeSenderMockRecorder {
+	return m.recorder
+}
+
+// SendCode mocks base method.
+func (m *MockCodeSender) SendCode(phone, code, action string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCode", phone, code, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCode indicates an expected call of SendCode.
+func (mr *MockCodeSenderMockRecorder) SendCode(phone, code, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCode", reflect.TypeOf((*MockCodeSender)(nil).SendCode), phone, code, action)
+}
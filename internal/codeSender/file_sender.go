@@ -0,0 +1,56 @@
+package codeSender
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+/**
+ * FileSender appends every message to a file instead of delivering it.
+ * It MUST only be used in development and test environments.
+ * If path is empty messages are written to standard output.
+ */
+type FileSender struct {
+	path      string
+	templates Templates
+	lock      *sync.Mutex
+}
+
+func NewFileSender(path string, templates Templates) FileSender {
+	return FileSender{
+		path:      path,
+		templates: templates,
+		lock:      &sync.Mutex{},
+	}
+}
+
+func (f FileSender) SendCode(phone string, code string, action string) error {
+	message, err := f.templates.render(action, code)
+	switch err != nil {
+	case true:
+		return err
+	}
+	line := fmt.Sprintf("%s %s %s: %s\n", time.Now().Format(time.RFC3339), phone, action, message)
+	switch f.path == "" {
+	case true:
+		_, err = fmt.Print(line)
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	switch err != nil {
+	case true:
+		return err
+	}
+	_, err = file.WriteString(line)
+	switch err != nil {
+	case true:
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,34 @@
+package codeSender
+
+import (
+	"fmt"
+	"strings"
+)
+
+/**
+ * Placeholder that will be replaced with the security code in message templates
+ */
+const CodePlaceholder = "{code}"
+
+/**
+ * Templates maps a security code action (SIGNUP, LOGIN, ...) to its message template.
+ * Every template must contain CodePlaceholder.
+ */
+type Templates map[string]string
+
+type TemplateNotFound struct {
+	Action string
+}
+
+func (e TemplateNotFound) Error() string {
+	return fmt.Sprintf("no message template defined for action %s", e.Action)
+}
+
+func (t Templates) render(action string, code string) (string, error) {
+	template, isset := t[action]
+	switch isset {
+	case false:
+		return "", TemplateNotFound{Action: action}
+	}
+	return strings.ReplaceAll(template, CodePlaceholder, code), nil
+}
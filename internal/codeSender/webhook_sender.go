@@ -0,0 +1,91 @@
+package codeSender
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+/**
+ * WebhookSender posts messages to an http endpoint of an SMS/voice gateway.
+ * Request body is a json object with phone, action and message fields.
+ * Any response status out of 2xx range is considered as a delivery failure.
+ */
+type WebhookSender struct {
+	url           string
+	authorization string
+	templates     Templates
+	client        *http.Client
+}
+
+type WebhookConfigs struct {
+	Url string
+	// Optional value for Authorization header of the requests
+	Authorization string
+	Timeout       time.Duration
+}
+
+type webhookMessage struct {
+	Phone   string `json:"phone"`
+	Action  string `json:"action"`
+	Message string `json:"message"`
+}
+
+type UnexpectedStatus struct {
+	Status int
+}
+
+func (e UnexpectedStatus) Error() string {
+	return fmt.Sprintf("webhook responded with unexpected status %d", e.Status)
+}
+
+func NewWebhookSender(configs WebhookConfigs, templates Templates) WebhookSender {
+	return WebhookSender{
+		url:           configs.Url,
+		authorization: configs.Authorization,
+		templates:     templates,
+		client: &http.Client{
+			Timeout: configs.Timeout,
+		},
+	}
+}
+
+func (w WebhookSender) SendCode(phone string, code string, action string) error {
+	message, err := w.templates.render(action, code)
+	switch err != nil {
+	case true:
+		return err
+	}
+	body, err := json.Marshal(webhookMessage{
+		Phone:   phone,
+		Action:  action,
+		Message: message,
+	})
+	switch err != nil {
+	case true:
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	switch err != nil {
+	case true:
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	switch w.authorization != "" {
+	case true:
+		request.Header.Set("Authorization", w.authorization)
+	}
+	response, err := w.client.Do(request)
+	switch err != nil {
+	case true:
+		return err
+	}
+	defer response.Body.Close()
+	switch response.StatusCode < 200 || response.StatusCode > 299 {
+	case true:
+		return UnexpectedStatus{Status: response.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	errors2 "errors"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"time"
+)
+
+/**
+ * Creates a new security code for deleting account of the user with given id. Security code is sent to the
+ * phone number of the user.
+ * Ip is the address of the caller and is used for rate limiting. Empty ip disables ip rate limit.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ * 3-SecurityCodeDeliveryFailed
+ * 4-TooManyRequests
+ */
+func (s Service) RequestDeleteAccountSecurityCode(userId string, ip string) error {
+	user, err := s.repository.GetUserById(userId)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	err = s.checkSecurityCodeRequestLimits(user.Phone, ip)
+	switch err != nil {
+	case true:
+		return err
+	}
+	return s.requestSecurityCode(user.Phone, security_code_delete_account_action)
+}
+
+/**
+ * Cancels the scheduled deletion of account of the user with given id.
+ * Returned errors:
+ * 1-InternalError
+ * 2-AccountDeletionNotScheduled
+ */
+func (s Service) CancelAccountDeletion(userId string) error {
+	_, err := s.repository.GetAccountDeletion(userId)
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case true:
+			return AccountDeletionNotScheduled{}
+		}
+		return errors.InternalError{}
+	}
+	err = s.repository.CancelAccountDeletion(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Deletes accounts that their grace period is over. Failures are reported and the deletion is retried
+ * in the next call. It is called periodically by a background worker.
+ */
+func (s Service) DeleteDueAccounts() {
+	deletions, err := s.repository.GetDueAccountDeletions(time.Now())
+	switch err != nil {
+	case true:
+		s.reportError("fetching due account deletions", err)
+		return
+	}
+	for _, deletion := range deletions {
+		err = s.executeAccountDeletion(deletion)
+		switch err != nil {
+		case true:
+			s.reportError("deleting account of user "+deletion.UserId, err)
+		}
+	}
+}
+
+func (s Service) executeAccountDeletion(deletion domain.AccountDeletion) error {
+	user, err := s.repository.GetUserById(deletion.UserId)
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case false:
+			return err
+		}
+	default:
+		err = s.deleteUser(user)
+		switch err != nil {
+		case true:
+			return err
+		}
+	}
+	return s.repository.CancelAccountDeletion(deletion.UserId)
+}
+
+func (s Service) scheduleAccountDeletion(userId string) (time.Time, error) {
+	now := time.Now()
+	deletion := domain.AccountDeletion{
+		UserId:      userId,
+		ScheduledAt: now,
+		DeleteAt:    now.Add(s.configs.AccountDeletionGracePeriod),
+	}
+	err := s.repository.ScheduleAccountDeletion(deletion)
+	switch err != nil {
+	case true:
+		return time.Time{}, errors.InternalError{}
+	}
+	return deletion.DeleteAt, nil
+}
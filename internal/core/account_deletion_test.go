@@ -0,0 +1,121 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+func newDeleteAccountSecurityCode() domain.SecurityCode {
+	deleteAccountSecurityCode := securityCode
+	deleteAccountSecurityCode.Action = security_code_delete_account_action
+	return deleteAccountSecurityCode
+}
+
+/**
+ * Test case for grace period. Deletion must be scheduled instead of deleting the account
+ */
+func TestService_DeleteUser5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	core.configs.AccountDeletionGracePeriod = time.Hour
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newDeleteAccountSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, gomock.Any()).Return(true, nil)
+	repositoryMock.EXPECT().ScheduleAccountDeletion(gomock.Any()).DoAndReturn(func(deletion domain.AccountDeletion) error {
+		switch deletion.UserId != user.Id || deletion.DeleteAt.Sub(deletion.ScheduledAt) != time.Hour {
+		case true:
+			t.Errorf("Expected deletion of the user to be scheduled after grace period. Scheduled deletion: %+v", deletion)
+		}
+		return nil
+	})
+
+	deleteAt, err := core.DeleteUser(user.Id, securityCodeRaw)
+	switch err != nil || deleteAt.IsZero() {
+	case true:
+		t.Errorf("Expected DeleteUser to schedule deletion. Returned time: %v, error: %v", deleteAt, err)
+	}
+}
+
+/**
+ * Test case for security codes of other actions
+ */
+func TestService_DeleteUser6(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginSecurityCode := newDeleteAccountSecurityCode()
+	loginSecurityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(loginSecurityCode, nil)
+
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
+	switch errors.As(err, &SecurityCodeActionDoesNotMatch{}) {
+	case false:
+		t.Errorf("Proper error not returned from DeleteUser. Expected DeleteUser to return SecurityCodeActionDoesNotMatch error")
+	}
+}
+
+/**
+ * Test case for replaying a delete account security code. Second request with the same code must fail
+ */
+func TestService_DeleteUser7(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	core.configs.AccountDeletionGracePeriod = time.Hour
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil).Times(2)
+	expectConsumableSecurityCode(user.Phone, newDeleteAccountSecurityCode())
+	repositoryMock.EXPECT().ScheduleAccountDeletion(gomock.Any())
+
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
+	switch err != nil {
+	case true:
+		t.Fatalf("Expected DeleteUser to schedule deletion but error returned. Error message: %v", err)
+	}
+	_, err = core.DeleteUser(user.Id, securityCodeRaw)
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from DeleteUser. Expected replayed security code to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Test case for not scheduled deletions
+ */
+func TestService_CancelAccountDeletion(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetAccountDeletion(user.Id).Return(domain.AccountDeletion{}, errors2.EntityNotFound{})
+
+	err := core.CancelAccountDeletion(user.Id)
+	switch errors.As(err, &AccountDeletionNotScheduled{}) {
+	case false:
+		t.Errorf("Proper error not returned from CancelAccountDeletion. Expected CancelAccountDeletion to return AccountDeletionNotScheduled error")
+	}
+}
+
+/**
+ * Normal test case. Schedule of deleted users and users that do not exist anymore must be removed
+ */
+func TestService_DeleteDueAccounts(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	deletedUserId := "11111111-1111-1111-1111-111111111111"
+	repositoryMock.EXPECT().GetDueAccountDeletions(gomock.Any()).Return([]domain.AccountDeletion{
+		{UserId: user.Id},
+		{UserId: deletedUserId},
+	}, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetUserById(deletedUserId).Return(domain.User{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return(nil, nil)
+	repositoryMock.EXPECT().DeleteUser(user)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().GetReverseContacts(user.Id).Return([]string{}, nil)
+	publisherMock.EXPECT().PublishUserDeleted(gomock.Any())
+	repositoryMock.EXPECT().CancelAccountDeletion(user.Id)
+	repositoryMock.EXPECT().CancelAccountDeletion(deletedUserId)
+
+	core.DeleteDueAccounts()
+}
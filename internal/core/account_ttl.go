@@ -0,0 +1,214 @@
+package core
+
+import (
+	errors2 "errors"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"sync"
+	"time"
+)
+
+/**
+ * Inactivity periods that users can choose in days. They are 1, 3, 6 and 12 months
+ */
+var accountTTLDays = []int{30, 90, 180, 365}
+
+/**
+ * When number of tracked users reaches this value, stale entries of activity tracker are removed
+ */
+const activity_tracker_prune_size = 100000
+
+/**
+ * activityTracker keeps the last time that activity of each user is recorded by this instance so that
+ * activities are written at most once per AccountActivityInterval
+ */
+type activityTracker struct {
+	lock       *sync.Mutex
+	recordedAt map[string]time.Time
+}
+
+func newActivityTracker() *activityTracker {
+	return &activityTracker{
+		lock:       &sync.Mutex{},
+		recordedAt: map[string]time.Time{},
+	}
+}
+
+/**
+ * Returns true if activity of the user must be recorded and marks it as recorded
+ */
+func (a *activityTracker) track(userId string, now time.Time, interval time.Duration) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	recordedAt, isset := a.recordedAt[userId]
+	switch isset && now.Sub(recordedAt) < interval {
+	case true:
+		return false
+	}
+	switch len(a.recordedAt) >= activity_tracker_prune_size {
+	case true:
+		for id, at := range a.recordedAt {
+			switch now.Sub(at) >= interval {
+			case true:
+				delete(a.recordedAt, id)
+			}
+		}
+	}
+	a.recordedAt[userId] = now
+	return true
+}
+
+/**
+ * Records activity of the user. It is called on login and authenticated rpcs.
+ * Activities are written at most once per AccountActivityInterval and failures are only reported.
+ */
+func (s Service) RecordActivity(userId string) {
+	now := time.Now()
+	switch s.activities.track(userId, now, s.configs.AccountActivityInterval) {
+	case false:
+		return
+	}
+	err := s.repository.RecordAccountActivity(userId, now)
+	switch err != nil {
+	case true:
+		s.reportError("recording activity of user "+userId, err)
+	}
+}
+
+/**
+ * Sets the inactivity period in days after which account of the user is deleted.
+ * Days must be one of 30, 90, 180 or 365.
+ * Returned errors:
+ * 1-InternalError
+ * 2-AccountTTLNotValid
+ */
+func (s Service) SetAccountTTL(userId string, days int) error {
+	isValid := false
+	for _, ttlDays := range accountTTLDays {
+		isValid = isValid || ttlDays == days
+	}
+	switch isValid {
+	case false:
+		return AccountTTLNotValid{}
+	}
+	err := s.repository.SetAccountTTL(userId, days)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns the inactivity period of the user in days. If the user has not set it, DefaultAccountTTLDays is returned.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) GetAccountTTL(userId string) (int, error) {
+	days, err := s.repository.GetAccountTTL(userId)
+	switch err != nil {
+	case true:
+		return 0, errors.InternalError{}
+	}
+	switch days == 0 {
+	case true:
+		return s.configs.DefaultAccountTTLDays, nil
+	}
+	return days, nil
+}
+
+/**
+ * Maximum number of user ids of inactive accounts that are listed in the summary of a dry run sweep
+ */
+const dry_run_candidates_limit = 100
+
+/**
+ * Summary of an inactive accounts sweep
+ */
+type InactiveAccountsSweep struct {
+	DryRun   bool
+	Pages    int
+	Scanned  int
+	Expired  int
+	Deleted  int
+	Orphaned int
+	Failed   int
+	// User ids of the first inactive accounts that would be deleted. Only set in dry run mode
+	Candidates []string
+}
+
+/**
+ * Deletes accounts that are inactive for more than their inactivity period by the same path as DeleteUser.
+ * Only users with a recorded activity are checked. Activity is recorded on signup so every user has one.
+ * Activities are scanned page by page. Activities of users that do not exist anymore are deleted.
+ * In dry run mode accounts are only counted and listed in the summary. Only failures are reported through
+ * error reporter and the result of the sweep is returned as a single summary.
+ * It is called periodically by a background worker.
+ */
+func (s Service) DeleteInactiveAccounts(dryRun bool) InactiveAccountsSweep {
+	sweep := InactiveAccountsSweep{DryRun: dryRun}
+	now := time.Now()
+	var pageState []byte
+	for {
+		activities, nextPageState, err := s.repository.GetAccountActivities(pageState)
+		switch err != nil {
+		case true:
+			s.reportError("fetching account activities", err)
+			sweep.Failed++
+			return sweep
+		}
+		sweep.Pages++
+		sweep.Scanned += len(activities)
+		for _, activity := range activities {
+			s.sweepInactiveAccount(activity, now, &sweep)
+		}
+		switch len(nextPageState) == 0 {
+		case true:
+			return sweep
+		}
+		pageState = nextPageState
+	}
+}
+
+/**
+ * Deletes account of the activity if it is expired and counts the result in sweep
+ */
+func (s Service) sweepInactiveAccount(activity domain.AccountActivity, now time.Time, sweep *InactiveAccountsSweep) {
+	days := activity.TTLDays
+	switch days == 0 {
+	case true:
+		days = s.configs.DefaultAccountTTLDays
+	}
+	switch activity.LastActivity.IsZero() || now.Sub(activity.LastActivity) < time.Duration(days)*24*time.Hour {
+	case true:
+		return
+	}
+	sweep.Expired++
+	switch sweep.DryRun {
+	case true:
+		switch len(sweep.Candidates) < dry_run_candidates_limit {
+		case true:
+			sweep.Candidates = append(sweep.Candidates, activity.UserId)
+		}
+		return
+	}
+	user, err := s.repository.GetUserById(activity.UserId)
+	switch {
+	case err == nil:
+		err = s.deleteUser(user)
+	case errors2.As(err, &errors.EntityNotFound{}):
+		err = s.repository.DeleteAccountActivity(activity.UserId)
+		switch err == nil {
+		case true:
+			sweep.Orphaned++
+			return
+		}
+	}
+	switch err != nil {
+	case true:
+		sweep.Failed++
+		s.reportError("deleting inactive account of user "+activity.UserId, err)
+		return
+	}
+	sweep.Deleted++
+}
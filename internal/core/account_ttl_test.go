@@ -0,0 +1,138 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+/**
+ * Test case for throttling. Activity must only be written once per activity interval
+ */
+func TestService_RecordActivity(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any()).Times(1)
+
+	core.RecordActivity(user.Id)
+	core.RecordActivity(user.Id)
+}
+
+/**
+ * Test case for inactivity periods that are not allowed
+ */
+func TestService_SetAccountTTL(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	err := core.SetAccountTTL(user.Id, 45)
+	switch errors.As(err, &AccountTTLNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetAccountTTL. Expected SetAccountTTL to return AccountTTLNotValid error")
+	}
+}
+
+/**
+ * Test case for users that have not set their inactivity period. Default value must be returned
+ */
+func TestService_GetAccountTTL(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetAccountTTL(user.Id).Return(0, nil)
+
+	days, err := core.GetAccountTTL(user.Id)
+	switch err != nil || days != dummyConfigs.DefaultAccountTTLDays {
+	case true:
+		t.Errorf("Expected GetAccountTTL to return default ttl. Returned days: %d, error: %v", days, err)
+	}
+}
+
+/**
+ * Test case for sweeping inactive accounts. Only accounts that are inactive for more than their ttl must be deleted
+ */
+func TestService_DeleteInactiveAccounts(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+	repositoryMock.EXPECT().GetAccountActivities(nil).Return([]domain.AccountActivity{
+		{UserId: user.Id, LastActivity: time.Now().Add(-31 * 24 * time.Hour), TTLDays: 30},
+		{UserId: "11111111-1111-1111-1111-111111111111", LastActivity: time.Now().Add(-31 * 24 * time.Hour)},
+	}, nil, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return(nil, nil)
+	repositoryMock.EXPECT().DeleteUser(user)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().GetReverseContacts(user.Id).Return([]string{}, nil)
+	publisherMock.EXPECT().PublishUserDeleted(gomock.Any())
+
+	sweep := core.DeleteInactiveAccounts(false)
+	switch sweep.Scanned != 2 || sweep.Expired != 1 || sweep.Deleted != 1 {
+	case true:
+		t.Errorf("Expected one of two scanned accounts to be deleted. Summary: %+v", sweep)
+	}
+}
+
+/**
+ * Test case for activities of users that do not exist anymore. Activities must be deleted
+ */
+func TestService_DeleteInactiveAccounts3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+	repositoryMock.EXPECT().GetAccountActivities(nil).Return([]domain.AccountActivity{
+		{UserId: user.Id, LastActivity: time.Now().Add(-31 * 24 * time.Hour), TTLDays: 30},
+	}, nil, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(domain.User{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().DeleteAccountActivity(user.Id)
+
+	sweep := core.DeleteInactiveAccounts(false)
+	switch sweep.Orphaned != 1 {
+	case true:
+		t.Errorf("Expected activity of the missing user to be deleted. Summary: %+v", sweep)
+	}
+}
+
+/**
+ * Test case for dry run. No account must be deleted and inactive accounts must be listed in the summary
+ */
+func TestService_DeleteInactiveAccounts2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+	repositoryMock.EXPECT().GetAccountActivities(nil).Return([]domain.AccountActivity{
+		{UserId: user.Id, LastActivity: time.Now().Add(-31 * 24 * time.Hour), TTLDays: 30},
+	}, nil, nil)
+
+	sweep := core.DeleteInactiveAccounts(true)
+	switch sweep.Expired != 1 || len(sweep.Candidates) != 1 || sweep.Candidates[0] != user.Id {
+	case true:
+		t.Errorf("Expected the inactive account to be listed as a candidate. Summary: %+v", sweep)
+	}
+}
+
+/**
+ * Test case for several pages of activities. Every page must be fetched by paging state of the previous page
+ */
+func TestService_DeleteInactiveAccounts4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+	nextPageState := []byte("next-page")
+	gomock.InOrder(
+		repositoryMock.EXPECT().GetAccountActivities(nil).Return([]domain.AccountActivity{
+			{UserId: user.Id, LastActivity: time.Now()},
+		}, nextPageState, nil),
+		repositoryMock.EXPECT().GetAccountActivities(nextPageState).Return([]domain.AccountActivity{
+			{UserId: "11111111-1111-1111-1111-111111111111", LastActivity: time.Now().Add(-31 * 24 * time.Hour), TTLDays: 30},
+		}, nil, nil),
+	)
+
+	sweep := core.DeleteInactiveAccounts(true)
+	switch sweep.Pages != 2 || sweep.Scanned != 2 || sweep.Expired != 1 {
+	case true:
+		t.Errorf("Expected both pages of activities to be scanned. Summary: %+v", sweep)
+	}
+}
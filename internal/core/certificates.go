@@ -0,0 +1,163 @@
+package core
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"math/big"
+	"time"
+)
+
+/**
+ * Revokes the certificate of the user with given serial and terminates its session. Serial MUST be taken from
+ * the verified client certificate of the caller so that only the holder of a certificate can log it out.
+ * Returned errors:
+ * 1-InternalError
+ * 2-CertificateNotFound
+ */
+func (s Service) Logout(userId string, serial string) error {
+	err := s.RevokeCertificate(userId, serial)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.repository.DeleteSessions(userId, []string{serial})
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Revokes the certificate of the user with given serial.
+ * Returned errors:
+ * 1-InternalError
+ * 2-CertificateNotFound
+ */
+func (s Service) RevokeCertificate(userId string, serial string) error {
+	certificates, err := s.repository.GetUserCertificates(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	for _, certificate := range certificates {
+		switch certificate.Serial == serial {
+		case true:
+			return s.revokeCertificates([]domain.Certificate{certificate})
+		}
+	}
+	return CertificateNotFound{}
+}
+
+func (s Service) IsCertificateRevoked(serial string) (bool, error) {
+	isRevoked, err := s.repository.IsCertificateRevoked(serial)
+	switch err != nil {
+	case true:
+		return false, errors.InternalError{}
+	}
+	return isRevoked, nil
+}
+
+/**
+ * Generates a DER encoded certificate revocation list of all revoked certificates that are not expired yet.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) GetCertificateRevocationList() ([]byte, error) {
+	certificates, err := s.repository.GetRevokedCertificates()
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	revoked := make([]pkix.RevokedCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		serial, isValid := new(big.Int).SetString(certificate.Serial, 10)
+		switch isValid {
+		case false:
+			continue
+		}
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: certificate.RevokedAt,
+		})
+	}
+	revocationList, err := s.certGen.NewRevocationList(revoked, time.Now().Add(s.configs.RevocationListValidity))
+	switch err != nil {
+	case true:
+		s.reportError("generating certificate revocation list", err)
+		return nil, errors.InternalError{}
+	}
+	return revocationList, nil
+}
+
+/**
+ * Revokes all certificates of the user that are not revoked yet
+ */
+func (s Service) revokeUserCertificates(userId string) error {
+	certificates, err := s.repository.GetUserCertificates(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	active := make([]domain.Certificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		switch certificate.RevokedAt.IsZero() {
+		case true:
+			active = append(active, certificate)
+		}
+	}
+	return s.revokeCertificates(active)
+}
+
+func (s Service) revokeCertificates(certificates []domain.Certificate) error {
+	switch len(certificates) == 0 {
+	case true:
+		return nil
+	}
+	now := time.Now()
+	for i := range certificates {
+		certificates[i].RevokedAt = now
+	}
+	err := s.repository.RevokeCertificates(certificates)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Authenticates the owner of a client certificate and returns the user id of the owner.
+ * Certificate must be issued by this service and must not be revoked.
+ * Returned errors:
+ * 1-InternalError
+ * 2-CertificateNotValid
+ */
+func (s Service) Authenticate(cert *x509.Certificate) (string, error) {
+	err := s.verifyUserCert(cert)
+	switch err != nil {
+	case true:
+		return "", err
+	}
+	isRevoked, err := s.repository.IsCertificateRevoked(cert.SerialNumber.String())
+	switch err != nil {
+	case true:
+		return "", errors.InternalError{}
+	}
+	switch isRevoked {
+	case true:
+		return "", CertificateNotValid{}
+	}
+	return cert.Subject.CommonName, nil
+}
+
+func (s Service) verifyUserCert(cert *x509.Certificate) error {
+	err := s.certGen.VerifyCertificate(cert)
+	switch err != nil || cert.Subject.CommonName == "" {
+	case true:
+		return CertificateNotValid{}
+	}
+	return nil
+}
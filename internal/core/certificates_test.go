@@ -0,0 +1,138 @@
+package core
+
+import (
+	"crypto/x509"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"github.com/zytell3301/tg-users-service/pkg/CertGen"
+	"testing"
+	"time"
+)
+
+/**
+ * Normal test case. Certificate must be revoked before deletion of its session
+ */
+func TestService_Logout(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return([]domain.Certificate{dummyCertificate}, nil)
+	gomock.InOrder(
+		repositoryMock.EXPECT().RevokeCertificates(gomock.Len(1)),
+		repositoryMock.EXPECT().DeleteSessions(user.Id, []string{dummyCertificate.Serial}),
+	)
+
+	err := core.Logout(user.Id, dummyCertificate.Serial)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected Logout to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Normal test case
+ */
+func TestService_RevokeCertificate(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return([]domain.Certificate{dummyCertificate}, nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Len(1)).DoAndReturn(func(certificates []domain.Certificate) error {
+		switch certificates[0].Serial != dummyCertificate.Serial || certificates[0].RevokedAt.IsZero() {
+		case true:
+			t.Errorf("Expected certificate %s to be revoked", dummyCertificate.Serial)
+		}
+		return nil
+	})
+
+	err := core.RevokeCertificate(user.Id, dummyCertificate.Serial)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected RevokeCertificate to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for unknown serial
+ */
+func TestService_RevokeCertificate2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return([]domain.Certificate{dummyCertificate}, nil)
+
+	err := core.RevokeCertificate(user.Id, "1")
+	switch errors.As(err, &CertificateNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from RevokeCertificate. Expected RevokeCertificate to return CertificateNotFound error")
+	}
+}
+
+/**
+ * Already revoked certificates must not be revoked again
+ */
+func TestService_revokeUserCertificates(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	revokedCertificate := dummyCertificate
+	revokedCertificate.Serial = "1"
+	revokedCertificate.RevokedAt = time.Now().Add(-time.Minute)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return([]domain.Certificate{revokedCertificate, dummyCertificate}, nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Len(1))
+
+	err := core.revokeUserCertificates(user.Id)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected revokeUserCertificates to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Normal test case
+ */
+func TestService_GetCertificateRevocationList(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	revokedCertificate := dummyCertificate
+	revokedCertificate.RevokedAt = time.Now()
+	repositoryMock.EXPECT().GetRevokedCertificates().Return([]domain.Certificate{revokedCertificate}, nil)
+	certGenMock.EXPECT().NewRevocationList(gomock.Len(1), gomock.Any()).Return([]byte("dummy crl"), nil)
+
+	revocationList, err := core.GetCertificateRevocationList()
+	switch err != nil || string(revocationList) != "dummy crl" {
+	case true:
+		t.Errorf("Expected GetCertificateRevocationList to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Normal test case
+ */
+func TestService_Authenticate(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	cert, _ := x509.ParseCertificate(CertGen.DecodePem(newDummyUserCert()).Bytes)
+	certGenMock.EXPECT().VerifyCertificate(cert).Return(nil)
+	repositoryMock.EXPECT().IsCertificateRevoked(dummySessionSerial).Return(false, nil)
+
+	userId, err := core.Authenticate(cert)
+	switch err != nil || userId != user.Id {
+	case true:
+		t.Errorf("Expected Authenticate to return id of the user. Returned id: %s, error: %v", userId, err)
+	}
+}
+
+/**
+ * Test case for revoked certificates
+ */
+func TestService_Authenticate2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	cert, _ := x509.ParseCertificate(CertGen.DecodePem(newDummyUserCert()).Bytes)
+	certGenMock.EXPECT().VerifyCertificate(cert).Return(nil)
+	repositoryMock.EXPECT().IsCertificateRevoked(dummySessionSerial).Return(true, nil)
+
+	_, err := core.Authenticate(cert)
+	switch errors.As(err, &CertificateNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from Authenticate. Expected Authenticate to return CertificateNotValid error")
+	}
+}
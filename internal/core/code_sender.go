@@ -0,0 +1,10 @@
+package core
+
+/**
+ * CodeSender delivers plain security codes to the owner of the phone number.
+ * Action is one of security code actions (SIGNUP, LOGIN, ...) so that implementations
+ * can choose a proper message for each action.
+ */
+type CodeSender interface {
+	SendCode(phone string, code string, action string) error
+}
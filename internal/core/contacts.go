@@ -0,0 +1,286 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"sort"
+	"strings"
+	"time"
+)
+
+/**
+ * Imports contacts of the owner. Contacts are matched to users by phone and contacts whose phone has no user are
+ * returned as not found phones. Users that do not let the owner find them by phone are reported as not found too,
+ * so importing contacts can not be used to bypass FIND_BY_PHONE privacy rule. Names are trimmed and truncated to profile limits. If a phone is repeated, its last
+ * contact is imported and importing an existing contact replaces its name. The owner itself is never imported.
+ * Phones that are new to the owner are limited by ContactsImportRateLimit.
+ * Returned errors:
+ * 1-InternalError
+ * 2-ContactsBatchTooLarge
+ * 3-TooManyRequests
+ */
+func (s Service) ImportContacts(ownerId string, contacts []domain.Contact) ([]domain.Contact, []string, error) {
+	switch len(contacts) > s.configs.ContactsImportMaxSize {
+	case true:
+		return nil, nil, ContactsBatchTooLarge{}
+	}
+	byPhone := make(map[string]domain.Contact, len(contacts))
+	phones := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		contact.Phone = strings.TrimSpace(contact.Phone)
+		switch contact.Phone == "" {
+		case true:
+			continue
+		}
+		_, isAdded := byPhone[contact.Phone]
+		switch isAdded {
+		case false:
+			phones = append(phones, contact.Phone)
+		}
+		byPhone[contact.Phone] = domain.Contact{
+			OwnerId:  ownerId,
+			Phone:    contact.Phone,
+			Name:     truncateRunes(strings.TrimSpace(contact.Name), name_max_length),
+			Lastname: truncateRunes(strings.TrimSpace(contact.Lastname), lastname_max_length),
+		}
+	}
+	notFound := make([]string, 0)
+	switch len(phones) == 0 {
+	case true:
+		return []domain.Contact{}, notFound, nil
+	}
+	err := s.checkContactsImportLimit(ownerId, phones)
+	switch err != nil {
+	case true:
+		return nil, nil, err
+	}
+	ids, err := s.repository.GetUserIdsByPhones(phones)
+	switch err != nil {
+	case true:
+		return nil, nil, errors.InternalError{}
+	}
+	matched := make([]string, 0, len(ids))
+	for _, id := range ids {
+		matched = append(matched, id)
+	}
+	view, err := s.newPrivacyView(Viewer{UserId: ownerId}, matched)
+	switch err != nil {
+	case true:
+		return nil, nil, err
+	}
+	imported := make([]domain.Contact, 0, len(ids))
+	for _, phone := range phones {
+		id, isFound := ids[phone]
+		switch {
+		case !isFound || !view.isVisible(id, privacy_rule_find_by_phone):
+			notFound = append(notFound, phone)
+			continue
+		case id == ownerId:
+			continue
+		}
+		contact := byPhone[phone]
+		contact.UserId = id
+		imported = append(imported, contact)
+	}
+	switch len(imported) == 0 {
+	case true:
+		return imported, notFound, nil
+	}
+	err = s.repository.AddContacts(imported)
+	switch err != nil {
+	case true:
+		return nil, nil, errors.InternalError{}
+	}
+	return imported, notFound, nil
+}
+
+/**
+ * Checks ContactsImportRateLimit of the owner and records the phones that count against it. Phones that are
+ * already contacts of the owner or are imported in the window are not counted, so importing the same contacts
+ * again is never limited. If new phones are more than the limit itself, ContactsBatchTooLarge is returned
+ * since the import can not succeed by waiting.
+ * Returned errors:
+ * 1-InternalError
+ * 2-ContactsBatchTooLarge
+ * 3-TooManyRequests
+ */
+func (s Service) checkContactsImportLimit(ownerId string, phones []string) error {
+	limit := s.configs.ContactsImportRateLimit
+	switch limit.Requests > 0 {
+	case false:
+		return nil
+	}
+	now := time.Now()
+	imports, err := s.repository.GetContactImports(ownerId, now.Add(-limit.Window))
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	contacts, err := s.repository.GetContacts(ownerId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	isKnown := make(map[string]bool, len(contacts))
+	for _, contact := range contacts {
+		isKnown[contact.Phone] = true
+	}
+	newPhones := make([]string, 0)
+	for _, phone := range phones {
+		_, isImported := imports[phone]
+		switch isKnown[phone] || isImported {
+		case false:
+			newPhones = append(newPhones, phone)
+		}
+	}
+	excess := len(imports) + len(newPhones) - limit.Requests
+	switch {
+	case len(newPhones) == 0:
+		return nil
+	case len(newPhones) > limit.Requests:
+		return ContactsBatchTooLarge{}
+	case excess > 0:
+		importedAt := make([]time.Time, 0, len(imports))
+		for _, at := range imports {
+			importedAt = append(importedAt, at)
+		}
+		sort.Slice(importedAt, func(i, j int) bool {
+			return importedAt[i].Before(importedAt[j])
+		})
+		return TooManyRequests{RetryAfter: importedAt[excess-1].Add(limit.Window).Sub(now)}
+	}
+	err = s.repository.RecordContactImports(ownerId, newPhones, now, limit.Window)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns contacts of the owner ordered by user id together with hash of them. IsMutual is set for contacts
+ * that have the owner in their contacts too.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) GetContacts(ownerId string) ([]domain.Contact, string, error) {
+	contacts, err := s.getSortedContacts(ownerId)
+	switch err != nil {
+	case true:
+		return nil, "", err
+	}
+	switch len(contacts) == 0 {
+	case true:
+		return contacts, hashContacts(contacts), nil
+	}
+	ids := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		ids = append(ids, contact.UserId)
+	}
+	owners, err := s.repository.GetContactOwners(ownerId, ids)
+	switch err != nil {
+	case true:
+		return nil, "", errors.InternalError{}
+	}
+	for i := range contacts {
+		contacts[i].IsMutual = owners[contacts[i].UserId]
+	}
+	return contacts, hashContacts(contacts), nil
+}
+
+/**
+ * Deletes contacts of the owner. Ids are case insensitive and ids that are not in contacts of the owner are ignored.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) DeleteContacts(ownerId string, userIds []string) error {
+	unique := uniqueIds(userIds)
+	switch len(unique) == 0 {
+	case true:
+		return nil
+	}
+	err := s.repository.DeleteContacts(ownerId, unique)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns the hash that GetContacts returns without computing mutual contacts. Clients compare it with the hash
+ * of their last fetched contacts to skip fetching unchanged contacts.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) GetContactsHash(ownerId string) (string, error) {
+	contacts, err := s.getSortedContacts(ownerId)
+	switch err != nil {
+	case true:
+		return "", err
+	}
+	return hashContacts(contacts), nil
+}
+
+func (s Service) getSortedContacts(ownerId string) ([]domain.Contact, error) {
+	contacts, err := s.repository.GetContacts(ownerId)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].UserId < contacts[j].UserId
+	})
+	return contacts, nil
+}
+
+/**
+ * Removes contacts of the user and the user from contacts of other users. It is called after the user is deleted
+ * so failures are only reported.
+ */
+func (s Service) deleteUserContacts(userId string) {
+	contacts, err := s.repository.GetContacts(userId)
+	switch err != nil {
+	case true:
+		s.reportError("fetching contacts of deleted user "+userId, err)
+	}
+	ids := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		ids = append(ids, contact.UserId)
+	}
+	switch len(ids) > 0 {
+	case true:
+		err = s.repository.DeleteContacts(userId, ids)
+		switch err != nil {
+		case true:
+			s.reportError("deleting contacts of deleted user "+userId, err)
+		}
+	}
+	owners, err := s.repository.GetReverseContacts(userId)
+	switch err != nil {
+	case true:
+		s.reportError("fetching owners of contacts of deleted user "+userId, err)
+		return
+	}
+	for _, owner := range owners {
+		err = s.repository.DeleteContacts(owner, []string{userId})
+		switch err != nil {
+		case true:
+			s.reportError("deleting deleted user "+userId+" from contacts of user "+owner, err)
+		}
+	}
+}
+
+/**
+ * Hash of contacts depends on their user ids and names only, so it changes when contacts are added, deleted or renamed.
+ * Contacts must be ordered by user id.
+ */
+func hashContacts(contacts []domain.Contact) string {
+	hash := sha256.New()
+	for _, contact := range contacts {
+		hash.Write([]byte(contact.UserId + ":" + contact.Name + ":" + contact.Lastname + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+/**
+ * Test case for importing contacts. Repeated phones must be imported once and phones without a user must be
+ * returned as not found
+ */
+func TestService_ImportContacts(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetContactImports(user.Id, gomock.Any()).Return(map[string]time.Time{}, nil)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().RecordContactImports(user.Id, []string{searchedUser.Phone, "+2222222222"}, gomock.Any(), dummyConfigs.ContactsImportRateLimit.Window)
+	repositoryMock.EXPECT().GetUserIdsByPhones([]string{searchedUser.Phone, "+2222222222"}).Return(map[string]string{
+		searchedUser.Phone: searchedUser.Id,
+	}, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{searchedUser.Id}).Return([]domain.PrivacyRule{}, nil)
+	repositoryMock.EXPECT().GetContactOwners(user.Id, []string{searchedUser.Id}).Return(map[string]bool{}, nil)
+	repositoryMock.EXPECT().AddContacts([]domain.Contact{{
+		OwnerId:  user.Id,
+		UserId:   searchedUser.Id,
+		Phone:    searchedUser.Phone,
+		Name:     "Arshiya",
+		Lastname: "Rahimi",
+	}})
+
+	imported, notFound, err := core.ImportContacts(user.Id, []domain.Contact{
+		{Phone: searchedUser.Phone, Name: "Arshiya"},
+		{Phone: " +2222222222 ", Name: "Nobody"},
+		{Phone: searchedUser.Phone, Name: " Arshiya ", Lastname: "Rahimi"},
+	})
+	switch err != nil || len(imported) != 1 || imported[0].UserId != searchedUser.Id || len(notFound) != 1 || notFound[0] != "+2222222222" {
+	case true:
+		t.Errorf("Expected ImportContacts to import matched phones once. Imported contacts: %v, not found phones: %v, error: %v", imported, notFound, err)
+	}
+}
+
+/**
+ * Test case for imports larger than ContactsImportMaxSize
+ */
+func TestService_ImportContacts2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	_, _, err := core.ImportContacts(user.Id, make([]domain.Contact, dummyConfigs.ContactsImportMaxSize+1))
+	switch errors.As(err, &ContactsBatchTooLarge{}) {
+	case false:
+		t.Errorf("Proper error not returned from ImportContacts. Expected ImportContacts to return ContactsBatchTooLarge error")
+	}
+}
+
+/**
+ * Test case for users that can not be found by phone. They must be reported as not found and not be imported
+ */
+func TestService_ImportContacts3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetContactImports(user.Id, gomock.Any()).Return(map[string]time.Time{}, nil)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().RecordContactImports(user.Id, []string{searchedUser.Phone}, gomock.Any(), gomock.Any())
+	repositoryMock.EXPECT().GetUserIdsByPhones([]string{searchedUser.Phone}).Return(map[string]string{
+		searchedUser.Phone: searchedUser.Id,
+	}, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{searchedUser.Id}).Return([]domain.PrivacyRule{
+		{UserId: searchedUser.Id, Rule: privacy_rule_find_by_phone, Visibility: privacy_visibility_nobody},
+	}, nil)
+	repositoryMock.EXPECT().GetContactOwners(user.Id, []string{searchedUser.Id}).Return(map[string]bool{}, nil)
+	repositoryMock.EXPECT().AddContacts(gomock.Any()).Times(0)
+
+	imported, notFound, err := core.ImportContacts(user.Id, []domain.Contact{
+		{Phone: searchedUser.Phone, Name: "Arshiya"},
+	})
+	switch err != nil || len(imported) != 0 || len(notFound) != 1 || notFound[0] != searchedUser.Phone {
+	case true:
+		t.Errorf("Expected ImportContacts to report hidden users as not found. Imported contacts: %v, not found phones: %v, error: %v", imported, notFound, err)
+	}
+}
+
+/**
+ * Test case for ContactsImportRateLimit. Phones that are already contacts or imported in the window must not be
+ * counted and the import must be rejected until enough of the recent imports leave the window
+ */
+func TestService_ImportContacts4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	now := time.Now()
+	repositoryMock.EXPECT().GetContactImports(user.Id, gomock.Any()).Return(map[string]time.Time{
+		"+3333333333": now.Add(-50 * time.Minute),
+		"+4444444444": now.Add(-40 * time.Minute),
+		"+5555555555": now.Add(-20 * time.Minute),
+	}, nil)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{{OwnerId: user.Id, UserId: searchedUser.Id, Phone: searchedUser.Phone}}, nil)
+	repositoryMock.EXPECT().RecordContactImports(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	repositoryMock.EXPECT().GetUserIdsByPhones(gomock.Any()).Times(0)
+
+	_, _, err := core.ImportContacts(user.Id, []domain.Contact{
+		{Phone: searchedUser.Phone},
+		{Phone: "+3333333333"},
+		{Phone: "+6666666666"},
+	})
+	tooManyRequests := TooManyRequests{}
+	switch errors.As(err, &tooManyRequests) {
+	case false:
+		t.Errorf("Proper error not returned from ImportContacts. Expected ImportContacts to return TooManyRequests error")
+		return
+	}
+	switch tooManyRequests.RetryAfter > 9*time.Minute && tooManyRequests.RetryAfter <= 10*time.Minute {
+	case false:
+		t.Errorf("Expected ImportContacts to retry after the oldest import leaves the window. Retry after: %v", tooManyRequests.RetryAfter)
+	}
+}
+
+/**
+ * Test case for contacts hash. Hash must not depend on order of stored contacts and mutual contacts
+ */
+func TestService_GetContacts(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	contacts := []domain.Contact{
+		{OwnerId: user.Id, UserId: strangerViewer.UserId, Name: "Stranger"},
+		{OwnerId: user.Id, UserId: searchedUser.Id, Name: "Arshiya"},
+	}
+	repositoryMock.EXPECT().GetContacts(user.Id).Return(contacts, nil)
+	repositoryMock.EXPECT().GetContactOwners(user.Id, []string{searchedUser.Id, strangerViewer.UserId}).Return(map[string]bool{
+		searchedUser.Id: true,
+	}, nil)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{contacts[1], contacts[0]}, nil)
+
+	fetched, hash, err := core.GetContacts(user.Id)
+	switch err != nil || len(fetched) != 2 || fetched[0].UserId != searchedUser.Id || !fetched[0].IsMutual || fetched[1].IsMutual {
+	case true:
+		t.Fatalf("Expected GetContacts to return sorted contacts with mutual status. Returned contacts: %v, error: %v", fetched, err)
+	}
+	currentHash, err := core.GetContactsHash(user.Id)
+	switch err != nil || currentHash != hash {
+	case true:
+		t.Errorf("Expected GetContactsHash to return the hash of GetContacts. Returned hash: %s, expected: %s, error: %v", currentHash, hash, err)
+	}
+}
+
+/**
+ * Test case for phone visibility of CONTACTS. Phone must only be visible to contacts of the user
+ */
+func TestService_GetUserById_contacts(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{user.Id}).Return([]domain.PrivacyRule{}, nil)
+	repositoryMock.EXPECT().GetContactOwners(strangerViewer.UserId, []string{user.Id}).Return(map[string]bool{user.Id: true}, nil)
+
+	viewed, err := core.GetUserById(strangerViewer, user.Id)
+	switch err != nil || viewed.Phone != user.Phone {
+	case true:
+		t.Errorf("Expected GetUserById to show phone of the user to its contacts. Returned user: %v, error: %v", viewed, err)
+	}
+}
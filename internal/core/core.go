@@ -11,33 +11,165 @@ import (
 	"github.com/zytell3301/tg-users-service/pkg/CertGen"
 	"golang.org/x/crypto/bcrypt"
 	"math/big"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Service struct {
-	repository UsersRepository
-	certGen    CertGen.Gen
+	configs     Configs
+	repository  UsersRepository
+	searchIndex SearchIndex
+	certGen     CertGen.Gen
+	codeSender  CodeSender
+	mailSender  MailSender
+	publisher   EventPublisher
+	activities  *activityTracker
+	presences   *presenceTracker
+	presenceHub *presenceHub
 }
 
 const (
 	security_code_signup_action = "SIGNUP"
 	security_code_login_action  = "LOGIN"
+	// Security codes of phone change are sent to the new and the current phone number of the user
+	security_code_change_phone_action         = "CHANGE_PHONE"
+	security_code_confirm_change_phone_action = "CONFIRM_CHANGE_PHONE"
+	security_code_delete_account_action       = "DELETE_ACCOUNT"
+	// Security codes of the actions below are sent to email address of the user
+	security_code_verify_recovery_email_action = "VERIFY_RECOVERY_EMAIL"
+	security_code_recover_password_action      = "RECOVER_PASSWORD"
 )
 
-func NewUsersCore(repository UsersRepository, certGen CertGen.Gen) Service {
+/**
+ * NotBefore of issued certificates is moved back by this value to tolerate clock differences of clients
+ */
+const certificate_clock_skew = 5 * time.Minute
+
+/**
+ * Scheme of uri SANs that carry tg specific user data in issued certificates
+ */
+const certificate_uri_scheme = "tg"
+
+/**
+ * Prefixes of rate limit keys of security code requests
+ */
+const (
+	phone_rate_limit_prefix = "phone:"
+	email_rate_limit_prefix = "email:"
+	ip_rate_limit_prefix    = "ip:"
+)
+
+/**
+ * Repository methods of one kind of security codes. Phone security codes are keyed by phone and email security
+ * codes are keyed by user id in their own table. Both kinds share verification, attempt counting and rate limiting.
+ */
+type securityCodeStore struct {
+	get               func(key string) (domain.SecurityCode, error)
+	incrementAttempts func(key string) (int, error)
+	delete            func(key string) error
+	consume           func(key string, code string) (bool, error)
+	rateLimitPrefix   string
+}
+
+func (s Service) phoneCodes() securityCodeStore {
+	return securityCodeStore{
+		get:               s.repository.GetSecurityCode,
+		incrementAttempts: s.repository.IncrementSecurityCodeAttempts,
+		delete:            s.repository.DeleteSecurityCode,
+		consume:           s.repository.ConsumeSecurityCode,
+		rateLimitPrefix:   phone_rate_limit_prefix,
+	}
+}
+
+func (s Service) emailCodes() securityCodeStore {
+	return securityCodeStore{
+		get:               s.repository.GetEmailCode,
+		incrementAttempts: s.repository.IncrementEmailCodeAttempts,
+		delete:            s.repository.DeleteEmailCode,
+		consume:           s.repository.ConsumeEmailCode,
+		rateLimitPrefix:   email_rate_limit_prefix,
+	}
+}
+
+type Configs struct {
+	// Number of failed verifications after which the security code is invalidated
+	SecurityCodeMaxAttempts int
+	// Minimum time between two security codes of a phone number
+	SecurityCodeResendCooldown time.Duration
+	SecurityCodePhoneRateLimit RateLimit
+	SecurityCodeIpRateLimit    RateLimit
+	// Validity period of issued login certificates
+	CertificateValidity time.Duration
+	// Time until next update of generated certificate revocation lists
+	RevocationListValidity time.Duration
+	// If set, all sessions of the user are terminated after changing phone number
+	ChangePhoneTerminatesSessions bool
+	// Time after which a requested account deletion is executed. Zero value deletes accounts immediately
+	AccountDeletionGracePeriod time.Duration
+	// Inactivity period of users that have not set their own in days
+	DefaultAccountTTLDays int
+	// Minimum time between two recorded activities of a user
+	AccountActivityInterval time.Duration
+	// Limit of failed password checks of a user
+	PasswordRateLimit RateLimit
+	// Time that a login with a verified security code waits for the password of the user
+	PendingLoginValidity time.Duration
+	// Maximum number of ids of a GetUsersByIds call
+	UsersBatchMaxSize int
+	// Time after the last heartbeat of an online user that it is considered offline
+	PresenceTimeout time.Duration
+	// Maximum number of contacts of an ImportContacts call
+	ContactsImportMaxSize int
+	// Limit of new phones that a user imports into its contacts
+	ContactsImportRateLimit RateLimit
+}
+
+/**
+ * Allows at most Requests number of requests in any Window duration.
+ * Zero value of Requests disables the limit.
+ */
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+func NewUsersCore(configs Configs, repository UsersRepository, searchIndex SearchIndex, certGen CertGen.Gen, codeSender CodeSender, mailSender MailSender, publisher EventPublisher) Service {
 	return Service{
-		repository: repository,
-		certGen:    certGen,
+		configs:     configs,
+		repository:  repository,
+		searchIndex: searchIndex,
+		certGen:     certGen,
+		codeSender:  codeSender,
+		mailSender:  mailSender,
+		publisher:   publisher,
+		activities:  newActivityTracker(),
+		presences:   newPresenceTracker(),
+		presenceHub: newPresenceHub(),
 	}
 }
 
 /**
- * Creates a new user if the phone number already exists. Otherwise it returns UserAlreadyExists error
+ * Creates a new user if the phone number does not exist. Otherwise it returns UserAlreadyExists error.
+ * Phone is claimed atomically by repository so that concurrent signups of a phone never create two users.
+ * Name, lastname and bio of the user must follow profile rules of UpdateProfile otherwise ProfileNotValid error is returned
+ * Activity of the new user is recorded so that accounts that never log in are expired by their inactivity period too.
+ * If phone is not in international format PhoneNotValid error will be returned.
  */
 func (s Service) NewUser(user domain.User, securityCode string) (err error) {
-	err = s.VerifySecurityCode(user.Phone, securityCode, security_code_signup_action)
+	switch qualifyPhone(user.Phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	user = trimProfile(user)
+	err = validateProfile(user, profileFields)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.consumeSecurityCode(user.Phone, securityCode, security_code_signup_action)
 	switch err != nil {
 	case true:
 		switch errors2.As(err, &errors.InternalError{}) {
@@ -47,40 +179,60 @@ func (s Service) NewUser(user domain.User, securityCode string) (err error) {
 			return err
 		}
 	}
-	doesExists, err := s.repository.DoesUserExists(user.Phone)
-	switch err != nil {
-	case true:
-		return errors.InternalError{}
-	}
-	switch doesExists {
-	case true:
-		return UserAlreadyExists{}
-	}
-	err = s.repository.NewUser(domain.User{
+	user = domain.User{
 		Name:     user.Name,
 		Lastname: user.Lastname,
+		Bio:      user.Bio,
 		Phone:    user.Phone,
-	})
+	}
+	id, isCreated, err := s.repository.NewUser(user)
 	switch err != nil {
 	case true:
 		return errors.InternalError{}
 	}
+	switch isCreated {
+	case false:
+		return UserAlreadyExists{}
+	}
+	s.indexUser(id, searchTokens(user))
+	s.RecordActivity(id)
 
 	return
 }
 
 /**
  * Generate a certificate for corresponding user if provided security code is correct.
+ * Issued certificate certifies the key of the client which is taken from certificateRequest (a PKCS#10 request
+ * in PEM or DER format) or if it is empty, from publicKey (a PKIX public key in PEM or DER format).
+ * Returned certificate is a PEM chain including the CA certificate.
+ * Every successful login is recorded as a new session. Only Device and Ip of the session are used.
+ * If the user has enabled two-step verification, no certificate is issued and PasswordRequired error is returned
+ * with a login token that must be passed to CheckPassword.
  * Returned errors:
  * 1-SecurityCodeNotValid
  * 2-InternalError
  * 3-UserNotFound
+ * 4-SecurityCodeAttemptsExceeded
+ * 5-PublicKeyNotValid
+ * 6-CertificateRequestNotValid
+ * 7-PublicKeyTooWeak
+ * 8-PasswordRequired
+ * 9-PhoneNotValid
  */
-func (s Service) Login(phone string, securityCode string) ([]byte, error) {
-	err := s.VerifySecurityCode(phone, securityCode, security_code_login_action)
+func (s Service) Login(phone string, securityCode string, publicKey []byte, certificateRequest []byte, session domain.Session) ([]byte, error) {
+	switch qualifyPhone(phone) {
+	case false:
+		return nil, PhoneNotValid{}
+	}
+	key, err := parseClientKey(publicKey, certificateRequest)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	err = s.consumeSecurityCode(phone, securityCode, security_code_login_action)
 	switch err != nil {
 	case true:
-		switch errors2.As(err, &SecurityCodeNotValid{}) {
+		switch errors2.As(err, &SecurityCodeNotValid{}) || errors2.As(err, &SecurityCodeAttemptsExceeded{}) {
 		case true:
 			return nil, err
 		default:
@@ -97,55 +249,178 @@ func (s Service) Login(phone string, securityCode string) ([]byte, error) {
 			return nil, errors.InternalError{}
 		}
 	}
-	cert, err := s.generateUserCert(user)
+	password, err := s.repository.GetPassword(user.Id)
+	switch {
+	case err == nil:
+		return nil, s.newPendingLogin(user, key, session, password)
+	case !errors2.As(err, &errors.EntityNotFound{}):
+		return nil, errors.InternalError{}
+	}
+	return s.issueLogin(user, key, session)
+}
+
+/**
+ * Issues a certificate for the key and records it as a new session of the user.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) issueLogin(user domain.User, key interface{}, session domain.Session) ([]byte, error) {
+	cert, certificate, err := s.generateUserCert(user, key)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	err = s.repository.RecordCertificate(certificate)
 	switch err != nil {
 	case true:
 		return nil, errors.InternalError{}
 	}
+	err = s.repository.NewSession(domain.Session{
+		UserId:    user.Id,
+		Serial:    certificate.Serial,
+		Device:    session.Device,
+		Ip:        session.Ip,
+		CreatedAt: certificate.IssuedAt,
+		ExpiresAt: certificate.ExpiresAt,
+	})
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	s.RecordActivity(user.Id)
 	return cert, nil
 }
 
 /**
- * Generates a certificate based on user credentials
+ * Extracts the key that must be certified for the client and checks its strength.
+ * Returned errors:
+ * 1-PublicKeyNotValid
+ * 2-CertificateRequestNotValid
+ * 3-PublicKeyTooWeak
  */
-func (s Service) generateUserCert(user domain.User) ([]byte, error) {
-	cert, err := s.certGen.NewCertificate(&x509.Certificate{
+func parseClientKey(publicKey []byte, certificateRequest []byte) (key interface{}, err error) {
+	switch len(certificateRequest) != 0 {
+	case true:
+		csr, err := CertGen.ParseCertificateRequest(certificateRequest)
+		switch err != nil {
+		case true:
+			return nil, CertificateRequestNotValid{}
+		}
+		key = csr.PublicKey
+	default:
+		key, err = CertGen.ParsePublicKey(publicKey)
+		switch err != nil {
+		case true:
+			return nil, PublicKeyNotValid{}
+		}
+	}
+	err = CertGen.CheckPublicKey(key)
+	switch err != nil {
+	case true:
+		switch errors2.Is(err, CertGen.ErrWeakKey) {
+		case true:
+			return nil, PublicKeyTooWeak{}
+		}
+		return nil, PublicKeyNotValid{}
+	}
+	return key, nil
+}
+
+/**
+ * Generates a client authentication certificate based on user credentials.
+ * User id is used as common name and the phone number and username are added as uri SANs
+ * so that other services can identify the user without querying this service.
+ * Record of the issued certificate is returned beside the certificate itself.
+ */
+func (s Service) generateUserCert(user domain.User, publicKey interface{}) ([]byte, domain.Certificate, error) {
+	now := time.Now()
+	uris := []*url.URL{
+		{Scheme: "tel", Opaque: user.Phone},
+	}
+	switch user.Username != "" {
+	case true:
+		uris = append(uris, &url.URL{Scheme: certificate_uri_scheme, Host: "username", Path: "/" + user.Username})
+	}
+	template := &x509.Certificate{
 		Subject: pkix.Name{
+			CommonName:   user.Id,
 			SerialNumber: user.Id,
 		},
-	})
+		URIs:                  uris,
+		NotBefore:             now.Add(-certificate_clock_skew),
+		NotAfter:              now.Add(s.configs.CertificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+	}
+	cert, err := s.certGen.NewCertificate(template, publicKey)
 	switch err != nil {
 	case true:
 		s.reportError("generating certificate", err)
+		return nil, domain.Certificate{}, err
 	}
-	return cert, err
+	return cert, domain.Certificate{
+		Serial:    template.SerialNumber.String(),
+		UserId:    user.Id,
+		IssuedAt:  now,
+		ExpiresAt: template.NotAfter,
+	}, nil
 }
 
 /**
- * Updates current user's username or sets a new one if the user currently don't have username.
- * First username is qualified under username policies and then the username existence is checked before update.
+ * Updates username of the user with given id or sets a new one if the user currently don't have username.
+ * First username is qualified under username policies and then the username is claimed atomically by repository
+ * so that concurrent claims of a username never succeed together.
  * If the username qualification failed UsernameNotQualified error is returned.
- * If the username exists UsernameAlreadyExists error will be returned.
+ * If the username is owned by another user UsernameAlreadyExists error will be returned.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UsernameNotQualified
+ * 3-UsernameAlreadyExists
+ * 4-UserNotFound
+ */
+func (s Service) UpdateUsername(userId string, username string) error {
+	return s.updateUsername(username, func() (domain.User, error) {
+		return s.repository.GetUserById(userId)
+	})
+}
+
+/**
+ * Admin version of UpdateUsername that identifies the user by phone. It MUST only be exposed to other services.
+ * If phone is not in international format PhoneNotValid error will be returned.
  */
-func (s Service) UpdateUsername(phone string, username string) (err error) {
+func (s Service) UpdateUsernameByPhone(phone string, username string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	return s.updateUsername(username, func() (domain.User, error) {
+		return s.repository.GetUserByPhone(phone)
+	})
+}
+
+func (s Service) updateUsername(username string, getUser func() (domain.User, error)) (err error) {
 	switch qualifyUsername(username) {
 	case false:
 		return UsernameNotQualified{}
 	}
-	doesExists, err := s.repository.DoesUsernameExists(username)
+	user, err := getUser()
 	switch err != nil {
 	case true:
-		return errors.InternalError{}
+		return userLookupError(err)
 	}
-	switch doesExists {
-	case true:
-		return UsernameAlreadyExists{}
-	}
-	err = s.repository.UpdateUsername(phone, username)
+	isClaimed, err := s.repository.UpdateUsername(user.Phone, username)
 	switch err != nil {
 	case true:
-		return errors.InternalError{}
+		return userLookupError(err)
 	}
+	switch isClaimed {
+	case false:
+		return UsernameAlreadyExists{}
+	}
+	user.Username = username
+	s.indexUser(user.Id, searchTokens(user))
 
 	return
 }
@@ -172,27 +447,126 @@ func qualifyUsername(username string) bool {
 }
 
 /**
- * Deletes user account.
- * @TODO other user data must be deleted like messages
+ * Phone numbers are in international format. A plus sign followed by 7 to 15 digits. Phone numbers are keys of
+ * security codes and users so every phone from outside must be qualified before it is used.
  */
-func (s Service) DeleteUser(phone string) (err error) {
-	err = s.repository.DeleteUser(phone)
+func qualifyPhone(phone string) bool {
+	isValid, _ := regexp.MatchString("^\\+\\d{7,15}$", phone)
+	return isValid
+}
+
+/**
+ * Deletes account of the user with given id if the security code of DELETE_ACCOUNT action is correct.
+ * If AccountDeletionGracePeriod is set, deletion is scheduled and returned time is the time that the account
+ * will be deleted. Scheduled deletions can be cancelled by CancelAccountDeletion during the grace period.
+ * Otherwise the account is deleted immediately and zero time is returned.
+ * All certificates of the user are revoked before deletion.
+ * Profile, username, pending security code and sessions of the user are deleted and a UserDeleted event is
+ * published so that other services like messages service can delete their data of the user.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ * 3-SecurityCodeNotValid
+ * 4-SecurityCodeActionDoesNotMatch
+ * 5-SecurityCodeAttemptsExceeded
+ */
+func (s Service) DeleteUser(userId string, securityCode string) (time.Time, error) {
+	user, err := s.repository.GetUserById(userId)
+	switch err != nil {
+	case true:
+		return time.Time{}, userLookupError(err)
+	}
+	err = s.consumeSecurityCode(user.Phone, securityCode, security_code_delete_account_action)
+	switch err != nil {
+	case true:
+		return time.Time{}, err
+	}
+	switch s.configs.AccountDeletionGracePeriod > 0 {
+	case true:
+		return s.scheduleAccountDeletion(user.Id)
+	}
+	return time.Time{}, s.deleteUser(user)
+}
+
+/**
+ * Admin version of DeleteUser that identifies the user by phone. It MUST only be exposed to other services.
+ * If phone is not in international format PhoneNotValid error will be returned.
+ */
+func (s Service) DeleteUserByPhone(phone string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	user, err := s.repository.GetUserByPhone(phone)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	return s.deleteUser(user)
+}
+
+func (s Service) deleteUser(user domain.User) (err error) {
+	err = s.revokeUserCertificates(user.Id)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.repository.DeleteUser(user)
 	switch err != nil {
 	case true:
 		return errors.InternalError{}
 	}
+	s.indexUser(user.Id, nil)
+	s.deleteUserContacts(user.Id)
+	/**
+	 * Account is already deleted at this point. Failure of publishing the event is only reported
+	 */
+	err = s.publisher.PublishUserDeleted(domain.UserDeleted{
+		UserId:    user.Id,
+		Phone:     user.Phone,
+		Username:  user.Username,
+		DeletedAt: time.Now(),
+	})
+	switch err != nil {
+	case true:
+		s.reportError("publishing user deleted event of user "+user.Id, err)
+	}
 
-	return
+	return nil
+}
+
+/**
+ * Maps errors of repository user lookups to core errors
+ */
+func userLookupError(err error) error {
+	switch errors2.As(err, &errors.EntityNotFound{}) {
+	case true:
+		return UserNotFound{}
+	}
+	return errors.InternalError{}
 }
 
 /**
  * Creates a new security code for only signing up.
  * If the user already exists UserAlreadyExists error will be returned.
+ * Ip is the address of the caller and is used for rate limiting. Empty ip disables ip rate limit.
  * Returned errors:
  * 1-InternalError
  * 2-UserAlreadyExists
+ * 3-SecurityCodeDeliveryFailed
+ * 4-TooManyRequests
+ * 5-PhoneNotValid
  */
-func (s Service) RequestSignupSecurityCode(phone string) error {
+func (s Service) RequestSignupSecurityCode(phone string, ip string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	err := s.checkSecurityCodeRequestLimits(phone, ip)
+	switch err != nil {
+	case true:
+		return err
+	}
 	doesExists, err := s.repository.DoesUserExists(phone)
 	switch err != nil {
 	case true:
@@ -209,11 +583,24 @@ func (s Service) RequestSignupSecurityCode(phone string) error {
 /**
  * Creates a new security code only for login.
  * If the user does not exists UserNotFound error will be returned
+ * Ip is the address of the caller and is used for rate limiting. Empty ip disables ip rate limit.
  * Returned errors:
  * 1-InternalError
  * 2-UserNotFound
+ * 3-SecurityCodeDeliveryFailed
+ * 4-TooManyRequests
+ * 5-PhoneNotValid
  */
-func (s Service) RequestLoginSecurityCode(phone string) error {
+func (s Service) RequestLoginSecurityCode(phone string, ip string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	err := s.checkSecurityCodeRequestLimits(phone, ip)
+	switch err != nil {
+	case true:
+		return err
+	}
 	doesExists, err := s.repository.DoesUserExists(phone)
 	switch err != nil {
 	case true:
@@ -227,52 +614,288 @@ func (s Service) RequestLoginSecurityCode(phone string) error {
 	}
 }
 
+/**
+ * Checks resend cooldown of the phone number and rate limits of the phone number and the ip.
+ * If the request is allowed it will be recorded for later checks.
+ * Returned errors:
+ * 1-InternalError
+ * 2-TooManyRequests
+ */
+func (s Service) checkSecurityCodeRequestLimits(phone string, ip string) error {
+	return s.checkCodeRequestLimits(s.phoneCodes(), phone, ip)
+}
+
+/**
+ * Checks limits like checkSecurityCodeRequestLimits for the key of given security code store.
+ * Keys of all stores share the phone rate limit.
+ * Returned errors:
+ * 1-InternalError
+ * 2-TooManyRequests
+ */
+func (s Service) checkCodeRequestLimits(store securityCodeStore, key string, ip string) error {
+	now := time.Now()
+	securityCode, err := store.get(key)
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case false:
+			return errors.InternalError{}
+		}
+	default:
+		retryAfter := securityCode.CreatedAt.Add(s.configs.SecurityCodeResendCooldown).Sub(now)
+		switch retryAfter > 0 {
+		case true:
+			return TooManyRequests{RetryAfter: retryAfter}
+		}
+	}
+
+	limits := map[string]RateLimit{
+		store.rateLimitPrefix + key: s.configs.SecurityCodePhoneRateLimit,
+	}
+	switch ip != "" {
+	case true:
+		limits[ip_rate_limit_prefix+ip] = s.configs.SecurityCodeIpRateLimit
+	}
+	for key, limit := range limits {
+		err = s.checkRateLimit(key, limit, now)
+		switch err != nil {
+		case true:
+			return err
+		}
+	}
+	for key, limit := range limits {
+		switch limit.Requests > 0 {
+		case true:
+			err = s.repository.RecordSecurityCodeRequest(key, now, limit.Window)
+			switch err != nil {
+			case true:
+				return errors.InternalError{}
+			}
+		}
+	}
+	return nil
+}
+
+/**
+ * Sliding window rate limit. If limit is reached, TooManyRequests error will be returned with the time
+ * remaining until the oldest request in the window leaves it.
+ */
+func (s Service) checkRateLimit(key string, limit RateLimit, now time.Time) error {
+	switch limit.Requests > 0 {
+	case false:
+		return nil
+	}
+	requests, err := s.repository.GetSecurityCodeRequests(key, now.Add(-limit.Window))
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	switch len(requests) >= limit.Requests {
+	case true:
+		oldest := requests[0]
+		for _, request := range requests {
+			switch request.Before(oldest) {
+			case true:
+				oldest = request
+			}
+		}
+		return TooManyRequests{RetryAfter: oldest.Add(limit.Window).Sub(now)}
+	}
+	return nil
+}
+
 /**
  * Creates a new security code but this method is not directly accessible from outside of package.
- * It is only available from RequestLoginSecurityCode or RequestSignupSecurityCode methods
+ * It is only available from RequestLoginSecurityCode or RequestSignupSecurityCode methods.
+ * Only the hash of the code is stored and the plain code is handed to code sender.
+ * If the delivery fails SecurityCodeDeliveryFailed error will be returned.
+ */
+func (s Service) requestSecurityCode(phone string, action string) error {
+	return s.requestUserSecurityCode("", phone, action)
+}
+
+/**
+ * Creates a new security code like requestSecurityCode that is bound to the user with given id.
+ * Security codes that are bound to a user must be verified by verifyUserSecurityCode.
  */
-func (s Service) requestSecurityCode(phone string, action string) (err error) {
-	securityCode := hashExpression(generateSecurityCode())
+func (s Service) requestUserSecurityCode(userId string, phone string, action string) (err error) {
+	code := generateSecurityCode()
 	err = s.repository.RecordSecurityCode(domain.SecurityCode{
 		Phone:        phone,
 		Action:       action,
-		SecurityCode: securityCode,
+		SecurityCode: hashExpression(code),
+		UserId:       userId,
 	})
+	switch err != nil {
+	case true:
+		return
+	}
+	err = s.codeSender.SendCode(phone, code, action)
+	switch err != nil {
+	case true:
+		s.reportError("sending security code", err)
+		return SecurityCodeDeliveryFailed{}
+	}
 	return
 }
 
 /**
- * Verifies given security code and action.
+ * Verifies given security code and action. The security code is not consumed so actions that are authorized by
+ * a security code must use consumeSecurityCode instead.
  * If the security code is incorrect SecurityCodeNotValid error will be returned.
  * If the security code is correct but the action is incorrect, SecurityCodeActionDoesNotMatch will be returned
+ * Every failed verification is counted and the security code is invalidated when the number of failed
+ * attempts reaches SecurityCodeMaxAttempts. In this case SecurityCodeAttemptsExceeded will be returned.
  * Returned errors:
  * 1-InternalError
  * 2-SecurityCodeNotValid
  * 3-SecurityCodeActionDoesNotMatch
- * @TODO Determine maximum attempts for security code validation
+ * 4-SecurityCodeAttemptsExceeded
+ * 5-PhoneNotValid
  */
 func (s Service) VerifySecurityCode(phone string, code string, action string) error {
-	securityCode, err := s.repository.GetSecurityCode(phone)
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	_, err := s.verifyCode(s.phoneCodes(), phone, code, action)
+	return err
+}
+
+/**
+ * Verifies the security code of the key in given store like VerifySecurityCode and returns the stored security
+ * code on success
+ */
+func (s Service) verifyCode(store securityCodeStore, key string, code string, action string) (domain.SecurityCode, error) {
+	securityCode, err := store.get(key)
 	switch err != nil {
 	case true:
 		switch errors2.As(err, &errors.EntityNotFound{}) {
 		case true:
-			return SecurityCodeNotValid{}
+			return domain.SecurityCode{}, SecurityCodeNotValid{}
 		}
-		return errors.InternalError{}
+		return domain.SecurityCode{}, errors.InternalError{}
+	}
+	switch securityCode.Attempts >= s.configs.SecurityCodeMaxAttempts {
+	case true:
+		return domain.SecurityCode{}, SecurityCodeAttemptsExceeded{}
 	}
 	switch checkHashMatch(code, securityCode.SecurityCode) {
 	case false:
-		return SecurityCodeNotValid{}
+		return domain.SecurityCode{}, s.recordFailedAttempt(store, key)
 	}
 	switch securityCode.Action != action {
 	case true:
-		return SecurityCodeActionDoesNotMatch{}
+		return domain.SecurityCode{}, SecurityCodeActionDoesNotMatch{}
+	}
+	return securityCode, nil
+}
+
+/**
+ * Verifies the security code like VerifySecurityCode and consumes it with a conditional delete so that a verified
+ * code can not be used again by any action, even by concurrent requests. If the code is consumed or replaced by
+ * another request meanwhile SecurityCodeNotValid error will be returned.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeNotValid
+ * 3-SecurityCodeActionDoesNotMatch
+ * 4-SecurityCodeAttemptsExceeded
+ */
+func (s Service) consumeSecurityCode(phone string, code string, action string) error {
+	return s.consumeCode(s.phoneCodes(), phone, code, action)
+}
+
+/**
+ * Consumes the security code of the key in given store like consumeSecurityCode
+ */
+func (s Service) consumeCode(store securityCodeStore, key string, code string, action string) error {
+	securityCode, err := s.verifyCode(store, key, code, action)
+	switch err != nil {
+	case true:
+		return err
+	}
+	return s.consumeVerifiedCode(store, key, securityCode)
+}
+
+/**
+ * Verifies the security code like VerifySecurityCode and also checks that it is issued for the user with given id.
+ * Security codes of other users are reported as SecurityCodeNotValid.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeNotValid
+ * 3-SecurityCodeActionDoesNotMatch
+ * 4-SecurityCodeAttemptsExceeded
+ */
+func (s Service) verifyUserSecurityCode(userId string, phone string, code string, action string) (domain.SecurityCode, error) {
+	securityCode, err := s.verifyCode(s.phoneCodes(), phone, code, action)
+	switch err != nil {
+	case true:
+		return domain.SecurityCode{}, err
+	}
+	switch securityCode.UserId != userId {
+	case true:
+		return domain.SecurityCode{}, SecurityCodeNotValid{}
+	}
+	return securityCode, nil
+}
+
+/**
+ * Consumes a verified security code of the key in given store with a conditional delete. If the code is consumed or replaced by another
+ * request meanwhile SecurityCodeNotValid error will be returned.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeNotValid
+ */
+func (s Service) consumeVerifiedCode(store securityCodeStore, key string, securityCode domain.SecurityCode) error {
+	consumed, err := store.consume(key, securityCode.SecurityCode)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	switch consumed {
+	case false:
+		return SecurityCodeNotValid{}
 	}
 	return nil
 }
 
-func (s Service) GetUserByUsername(username string) (domain.User, error) {
+/**
+ * Counts a failed security code verification and invalidates the security code if
+ * maximum attempts reached.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeNotValid
+ * 3-SecurityCodeAttemptsExceeded
+ */
+func (s Service) recordFailedAttempt(store securityCodeStore, key string) error {
+	attempts, err := store.incrementAttempts(key)
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case true:
+			return SecurityCodeNotValid{}
+		}
+		return errors.InternalError{}
+	}
+	switch attempts >= s.configs.SecurityCodeMaxAttempts {
+	case true:
+		err = store.delete(key)
+		switch err != nil {
+		case true:
+			return errors.InternalError{}
+		}
+		return SecurityCodeAttemptsExceeded{}
+	}
+	return SecurityCodeNotValid{}
+}
+
+/**
+ * Privacy rules of the user are applied for the viewer.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ */
+func (s Service) GetUserByUsername(viewer Viewer, username string) (domain.User, error) {
 	user, err := s.repository.GetUserByUsername(username)
 	switch err != nil {
 	case true:
@@ -282,7 +905,122 @@ func (s Service) GetUserByUsername(username string) (domain.User, error) {
 		}
 		return domain.User{}, errors.InternalError{}
 	}
-	return user, nil
+	return s.viewSingleUser(viewer, user)
+}
+
+/**
+ * Privacy rules of the user are applied for the viewer.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ */
+func (s Service) GetUserById(viewer Viewer, id string) (domain.User, error) {
+	user, err := s.repository.GetUserById(id)
+	switch err != nil {
+	case true:
+		return domain.User{}, userLookupError(err)
+	}
+	return s.viewSingleUser(viewer, user)
+}
+
+func (s Service) viewSingleUser(viewer Viewer, user domain.User) (domain.User, error) {
+	view, err := s.newPrivacyView(viewer, []string{user.Id})
+	switch err != nil {
+	case true:
+		return domain.User{}, err
+	}
+	return s.viewUser(view, user), nil
+}
+
+/**
+ * Looks up users by a single repository query. Found users are returned keyed by the requested ids and ids
+ * without a user are missing from the result. Ids are case insensitive and duplicate ids are looked up once.
+ * Privacy rules of the users are applied for the viewer.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UsersBatchTooLarge
+ */
+func (s Service) GetUsersByIds(viewer Viewer, ids []string) (map[string]domain.User, error) {
+	unique := uniqueIds(ids)
+	switch len(unique) > s.configs.UsersBatchMaxSize {
+	case true:
+		return nil, UsersBatchTooLarge{}
+	}
+	found, err := s.repository.GetUsersByIds(unique)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	foundIds := make([]string, 0, len(found))
+	for id := range found {
+		foundIds = append(foundIds, id)
+	}
+	view, err := s.newPrivacyView(viewer, foundIds)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	users := make(map[string]domain.User, len(found))
+	for _, id := range ids {
+		user, isFound := found[strings.ToLower(id)]
+		switch isFound {
+		case true:
+			users[id] = s.viewUser(view, user)
+		}
+	}
+	return users, nil
+}
+
+/**
+ * Returns lowercase ids without duplicates in the order of their first occurrence
+ */
+func uniqueIds(ids []string) []string {
+	unique := make([]string, 0, len(ids))
+	isAdded := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		id = strings.ToLower(id)
+		switch isAdded[id] {
+		case true:
+			continue
+		}
+		isAdded[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+/**
+ * Users that do not let the viewer find them by phone are reported as not found. Anonymous viewers
+ * can not find any user so that phone numbers are not enumerable without an account.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ * 3-PhoneNotValid
+ */
+func (s Service) GetUserByPhone(viewer Viewer, phone string) (domain.User, error) {
+	switch qualifyPhone(phone) {
+	case false:
+		return domain.User{}, PhoneNotValid{}
+	}
+	switch viewer.UserId == "" && !viewer.IsAdmin {
+	case true:
+		return domain.User{}, UserNotFound{}
+	}
+	user, err := s.repository.GetUserByPhone(phone)
+	switch err != nil {
+	case true:
+		return domain.User{}, userLookupError(err)
+	}
+	view, err := s.newPrivacyView(viewer, []string{user.Id})
+	switch err != nil {
+	case true:
+		return domain.User{}, err
+	}
+	switch view.isVisible(user.Id, privacy_rule_find_by_phone) {
+	case false:
+		return domain.User{}, UserNotFound{}
+	}
+	return s.viewUser(view, user), nil
 }
 
 /**
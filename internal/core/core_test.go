@@ -2,16 +2,31 @@ package core
 
 import (
 	"bou.ke/monkey"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"github.com/golang/mock/gomock"
 	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/codeSender"
 	"github.com/zytell3301/tg-users-service/internal/domain"
 	"github.com/zytell3301/tg-users-service/internal/errorReporter"
+	"github.com/zytell3301/tg-users-service/internal/eventPublisher"
+	"github.com/zytell3301/tg-users-service/internal/mailSender"
 	"github.com/zytell3301/tg-users-service/internal/repository"
+	"github.com/zytell3301/tg-users-service/internal/searchIndex"
 	"github.com/zytell3301/tg-users-service/pkg/CertGen"
 	"golang.org/x/crypto/bcrypt"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type qualifyUsername_parameter struct {
@@ -37,6 +52,10 @@ var controller *gomock.Controller
 var repositoryMock *repository.MockUsersRepository
 var reporterMock *MockReporter
 var certGenMock *CertGen.MockGen
+var codeSenderMock *codeSender.MockCodeSender
+var mailSenderMock *mailSender.MockMailSender
+var publisherMock *eventPublisher.MockEventPublisher
+var memoryIndex searchIndex.MemoryIndex
 var core Service
 
 var securityCodeRaw = "123456"
@@ -46,12 +65,59 @@ var dummyError = errors.New("")
 var dummyInstanceId = "b8b342e2-3c8a-41f6-8f28-53042ae12519"
 var dummyServiceId = "199adc34-f9fd-425e-b721-d5e2b400d289"
 
+var dummyConfigs = Configs{
+	SecurityCodeMaxAttempts:    3,
+	SecurityCodeResendCooldown: time.Minute,
+	SecurityCodePhoneRateLimit: RateLimit{
+		Requests: 3,
+		Window:   time.Hour,
+	},
+	SecurityCodeIpRateLimit: RateLimit{
+		Requests: 10,
+		Window:   time.Hour,
+	},
+	CertificateValidity:     30 * 24 * time.Hour,
+	DefaultAccountTTLDays:   180,
+	AccountActivityInterval: time.Hour,
+	PasswordRateLimit: RateLimit{
+		Requests: 5,
+		Window:   time.Hour,
+	},
+	PendingLoginValidity:  5 * time.Minute,
+	UsersBatchMaxSize:     3,
+	PresenceTimeout:       5 * time.Minute,
+	ContactsImportMaxSize: 3,
+	ContactsImportRateLimit: RateLimit{
+		Requests: 3,
+		Window:   time.Hour,
+	},
+}
+
+var dummyIp = "127.0.0.1"
+var dummySession = domain.Session{
+	Device: "tg desktop 1.0.0",
+	Ip:     dummyIp,
+}
+
 var generateUserCertError bool
 var dummyUserCert = []byte("dummy cert")
+var dummyPublicKey []byte
+var dummyCertificate = domain.Certificate{
+	Serial:    "1234567890",
+	UserId:    user.Id,
+	IssuedAt:  time.Now(),
+	ExpiresAt: time.Now().Add(time.Hour),
+}
+var dummyCertificateRequest []byte
 
 func init() {
 	hashedSecurityCode, _ := bcrypt.GenerateFromPassword([]byte(securityCodeRaw), 12)
 	securityCode.SecurityCode = string(hashedSecurityCode)
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	publicKey, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	dummyPublicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	csr, _ := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	dummyCertificateRequest = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
 }
 
 func refresh(t *testing.T) {
@@ -59,8 +125,12 @@ func refresh(t *testing.T) {
 	repositoryMock = repository.NewMockUsersRepository(controller)
 	reporterMock = NewMockReporter(controller)
 	certGenMock = CertGen.NewMockGen(controller)
+	codeSenderMock = codeSender.NewMockCodeSender(controller)
+	mailSenderMock = mailSender.NewMockMailSender(controller)
+	publisherMock = eventPublisher.NewMockEventPublisher(controller)
+	memoryIndex = searchIndex.NewMemoryIndex()
 	errorReporter.InitiateReporter(dummyInstanceId, dummyServiceId, reporterMock)
-	core = NewUsersCore(repositoryMock, certGenMock)
+	core = NewUsersCore(dummyConfigs, repositoryMock, memoryIndex, certGenMock, codeSenderMock, mailSenderMock, publisherMock)
 }
 
 func newController(t *testing.T) *gomock.Controller {
@@ -83,12 +153,12 @@ func patchGenerateUserCert() {
 	monkey.Patch(Service.generateUserCert, generateUserCertPatch)
 }
 
-func generateUserCertPatch(core Service, user domain.User) ([]byte, error) {
+func generateUserCertPatch(core Service, user domain.User, publicKey interface{}) ([]byte, domain.Certificate, error) {
 	switch generateUserCertError {
 	case true:
-		return dummyUserCert, dummyError
+		return dummyUserCert, domain.Certificate{}, dummyError
 	default:
-		return dummyUserCert, nil
+		return dummyUserCert, dummyCertificate, nil
 	}
 }
 
@@ -99,12 +169,13 @@ func TestService_NewUser(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 	repositoryMock.EXPECT().NewUser(domain.User{
 		Name:     user.Name,
 		Lastname: user.Lastname,
 		Phone:    user.Phone,
-	})
-	repositoryMock.EXPECT().DoesUserExists(user.Phone)
+	}).Return(user.Id, true, nil)
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any())
 
 	err := core.NewUser(user, securityCodeRaw)
 
@@ -120,9 +191,9 @@ func TestService_NewUser(t *testing.T) {
 func TestService_NewUser2(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().NewUser(user).AnyTimes()
-	repositoryMock.EXPECT().DoesUserExists(user.Phone).Return(true, nil)
+	repositoryMock.EXPECT().NewUser(gomock.Any()).Return("", false, nil)
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 
 	err := core.NewUser(user, securityCodeRaw)
 	switch err == nil || !errors.As(err, &UserAlreadyExists{}) {
@@ -141,9 +212,9 @@ func TestService_NewUser3(t *testing.T) {
 		Name:     user.Name,
 		Lastname: user.Lastname,
 		Phone:    user.Phone,
-	}).Return(dummyError)
-	repositoryMock.EXPECT().DoesUserExists(user.Phone).Return(false, nil)
+	}).Return("", false, dummyError)
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 
 	err := core.NewUser(user, securityCodeRaw)
 	switch err == nil {
@@ -163,8 +234,7 @@ func TestService_NewUser3(t *testing.T) {
 func TestService_NewUser4(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DoesUserExists(user.Phone).Return(false, dummyError)
-	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, dummyError)
 
 	err := core.NewUser(user, securityCodeRaw)
 	switch err == nil {
@@ -183,10 +253,10 @@ func TestService_NewUser4(t *testing.T) {
 func TestService_UpdateUsername(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DoesUsernameExists(newUsername).Return(false, nil)
-	repositoryMock.EXPECT().UpdateUsername(user.Phone, newUsername)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().UpdateUsername(user.Phone, newUsername).Return(true, nil)
 
-	err := core.UpdateUsername(user.Phone, newUsername)
+	err := core.UpdateUsername(user.Id, newUsername)
 
 	switch err != nil {
 	case true:
@@ -200,9 +270,10 @@ func TestService_UpdateUsername(t *testing.T) {
 func TestService_UpdateUsername2(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DoesUsernameExists(newUsername).Return(true, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().UpdateUsername(user.Phone, newUsername).Return(false, nil)
 
-	err := core.UpdateUsername(user.Phone, newUsername)
+	err := core.UpdateUsername(user.Id, newUsername)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected UpdateUsername to return error but no error returned")
@@ -219,9 +290,9 @@ func TestService_UpdateUsername2(t *testing.T) {
 func TestService_UpdateUsername3(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DoesUsernameExists(newUsername).Return(false, dummyError)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(domain.User{}, errors2.InternalError{})
 
-	err := core.UpdateUsername(user.Phone, newUsername)
+	err := core.UpdateUsername(user.Id, newUsername)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected UpdateUsername to return error but no error returned")
@@ -238,10 +309,10 @@ func TestService_UpdateUsername3(t *testing.T) {
 func TestService_UpdateUsername4(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DoesUsernameExists(newUsername).Return(false, nil)
-	repositoryMock.EXPECT().UpdateUsername(user.Phone, newUsername).Return(dummyError)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().UpdateUsername(user.Phone, newUsername).Return(false, errors2.InternalError{})
 
-	err := core.UpdateUsername(user.Phone, newUsername)
+	err := core.UpdateUsername(user.Id, newUsername)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected UpdateUsername to return error but no error returned")
@@ -252,30 +323,116 @@ func TestService_UpdateUsername4(t *testing.T) {
 	}
 }
 
+/**
+ * Test case for mapping of concurrent username claims. Exactly one of the callers whose claim is accepted by
+ * repository must succeed and others must get UsernameAlreadyExists error. Atomicity of the claim itself is
+ * covered by TestRepository_UpdateUsername2
+ */
+func TestService_UpdateUsername5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	const callers = 10
+	owners := map[string]string{}
+	lock := sync.Mutex{}
+	repositoryMock.EXPECT().GetUserById(gomock.Any()).DoAndReturn(func(id string) (domain.User, error) {
+		return domain.User{Id: id, Phone: "phone-" + id}, nil
+	}).Times(callers)
+	repositoryMock.EXPECT().UpdateUsername(gomock.Any(), newUsername).DoAndReturn(func(phone string, username string) (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		owner, isset := owners[username]
+		switch isset {
+		case true:
+			return owner == phone, nil
+		}
+		owners[username] = phone
+		return true, nil
+	}).Times(callers)
+
+	results := make(chan error, callers)
+	wg := sync.WaitGroup{}
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			results <- core.UpdateUsername(id, newUsername)
+		}(strconv.Itoa(i))
+	}
+	wg.Wait()
+	close(results)
+
+	succeeded := 0
+	for err := range results {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.As(err, &UsernameAlreadyExists{}):
+			t.Errorf("Proper error not returned from UpdateUsername. Expected UpdateUsername to return UsernameAlreadyExists error but got: %v", err)
+		}
+	}
+	switch succeeded != 1 {
+	case true:
+		t.Errorf("Expected exactly one concurrent UpdateUsername to succeed but %d succeeded", succeeded)
+	}
+}
+
 /**
  * Test case for normal request
  */
 func TestService_DeleteUser(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DeleteUser(user.Phone)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newDeleteAccountSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, gomock.Any()).Return(true, nil)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return([]domain.Certificate{dummyCertificate}, nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Len(1))
+	repositoryMock.EXPECT().DeleteUser(user)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().GetReverseContacts(user.Id).Return([]string{}, nil)
+	publisherMock.EXPECT().PublishUserDeleted(gomock.Any()).DoAndReturn(func(event domain.UserDeleted) error {
+		switch event.UserId != user.Id || event.Phone != user.Phone || event.DeletedAt.IsZero() {
+		case true:
+			t.Errorf("Expected UserDeleted event of the user to be published. Published event: %+v", event)
+		}
+		return nil
+	})
 
-	err := core.DeleteUser(user.Phone)
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
 	switch err != nil {
 	case true:
 		t.Errorf("Expected DeleteUser to succeed but error returned. Error message: %v", err)
 	}
 }
 
+/**
+ * Test case for not existing user
+ */
+func TestService_DeleteUser3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(domain.User{}, errors2.EntityNotFound{})
+
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from DeleteUser. Expected DeleteUser to return UserNotFound error")
+	}
+}
+
 /**
  * test case for internal failure
  */
 func TestService_DeleteUser2(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().DeleteUser(user.Phone).Return(dummyError)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newDeleteAccountSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, gomock.Any()).Return(true, nil)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return(nil, nil)
+	repositoryMock.EXPECT().DeleteUser(user).Return(dummyError)
 
-	err := core.DeleteUser(user.Phone)
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected DeleteUser to return error but no error returned")
@@ -286,24 +443,70 @@ func TestService_DeleteUser2(t *testing.T) {
 	}
 }
 
+/**
+ * Test case for admin deletion by phone
+ */
+func TestService_DeleteUserByPhone(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return(nil, nil)
+	repositoryMock.EXPECT().DeleteUser(user)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().GetReverseContacts(user.Id).Return([]string{}, nil)
+	publisherMock.EXPECT().PublishUserDeleted(gomock.Any())
+
+	err := core.DeleteUserByPhone(user.Phone)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected DeleteUserByPhone to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for publish failure. Deletion must succeed and the failure must be reported
+ */
+func TestService_DeleteUser4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newDeleteAccountSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, gomock.Any()).Return(true, nil)
+	repositoryMock.EXPECT().GetUserCertificates(user.Id).Return(nil, nil)
+	repositoryMock.EXPECT().DeleteUser(user)
+	repositoryMock.EXPECT().GetContacts(user.Id).Return([]domain.Contact{}, nil)
+	repositoryMock.EXPECT().GetReverseContacts(user.Id).Return([]string{}, nil)
+	publisherMock.EXPECT().PublishUserDeleted(gomock.Any()).Return(dummyError)
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+
+	_, err := core.DeleteUser(user.Id, securityCodeRaw)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected DeleteUser to succeed but error returned. Error message: %v", err)
+	}
+}
+
 /**
  * Normal test case
  */
 func TestService_RequestSecurityCode(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().RecordSecurityCode(domain.SecurityCode{
-		Phone:        user.Phone,
-		SecurityCode: securityCode.SecurityCode,
-		Action:       security_code_signup_action,
-	}).Return(nil)
-	monkey.Patch(hashExpression, hashExpressionPatch)
-	defer monkey.UnpatchAll()
+	recorded := domain.SecurityCode{}
+	repositoryMock.EXPECT().RecordSecurityCode(gomock.Any()).DoAndReturn(func(code domain.SecurityCode) error {
+		recorded = code
+		return nil
+	})
+	sent := ""
+	codeSenderMock.EXPECT().SendCode(user.Phone, gomock.Any(), security_code_signup_action).DoAndReturn(func(phone string, code string, action string) error {
+		sent = code
+		return nil
+	})
 
 	err := core.requestSecurityCode(user.Phone, security_code_signup_action)
-	switch err != nil {
+	switch err != nil || recorded.Phone != user.Phone || recorded.Action != security_code_signup_action || !checkHashMatch(sent, recorded.SecurityCode) {
 	case true:
-		t.Errorf("Expected requestSecurityCode method to succeed but an error returned. Error message %v", err)
+		t.Errorf("Expected requestSecurityCode method to record hash of the sent code. Recorded code: %v, error: %v", recorded, err)
 	}
 }
 
@@ -313,14 +516,7 @@ func TestService_RequestSecurityCode(t *testing.T) {
 func TestService_RequestSecurityCode2(t *testing.T) {
 	refresh(t)
 	defer controller.Finish()
-	repositoryMock.EXPECT().RecordSecurityCode(domain.SecurityCode{
-		Phone:        user.Phone,
-		SecurityCode: securityCode.SecurityCode,
-		Action:       security_code_signup_action,
-	}).Return(dummyError)
-
-	monkey.Patch(hashExpression, hashExpressionPatch)
-	defer monkey.UnpatchAll()
+	repositoryMock.EXPECT().RecordSecurityCode(gomock.Any()).Return(dummyError)
 
 	err := core.requestSecurityCode(user.Phone, security_code_signup_action)
 	switch err == nil {
@@ -329,6 +525,23 @@ func TestService_RequestSecurityCode2(t *testing.T) {
 	}
 }
 
+/**
+ * Test case for security code delivery failure
+ */
+func TestService_RequestSecurityCode3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().RecordSecurityCode(gomock.Any()).Return(nil)
+	codeSenderMock.EXPECT().SendCode(user.Phone, gomock.Any(), security_code_login_action).Return(dummyError)
+	reporterMock.EXPECT().Report(gomock.Any()).AnyTimes()
+
+	err := core.requestSecurityCode(user.Phone, security_code_login_action)
+	switch errors.As(err, &SecurityCodeDeliveryFailed{}) {
+	case false:
+		t.Errorf("Proper error not returned from requestSecurityCode. Expected requestSecurityCode to return SecurityCodeDeliveryFailed error")
+	}
+}
+
 /**
  * Test cases for invalid parameters
  */
@@ -434,10 +647,22 @@ func TestService_Login(t *testing.T) {
 	defer controller.Finish()
 	securityCode.Action = security_code_login_action
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(domain.Password{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().RecordCertificate(dummyCertificate)
+	repositoryMock.EXPECT().NewSession(domain.Session{
+		UserId:    user.Id,
+		Serial:    dummyCertificate.Serial,
+		Device:    dummySession.Device,
+		Ip:        dummySession.Ip,
+		CreatedAt: dummyCertificate.IssuedAt,
+		ExpiresAt: dummyCertificate.ExpiresAt,
+	})
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any())
 	patchGenerateUserCert()
 	defer monkey.UnpatchAll()
-	cert, err := core.Login(user.Phone, securityCodeRaw)
+	cert, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
 	switch err != nil && string(cert) == string(dummyUserCert) {
 	case true:
 		t.Errorf("Expected method Login to succeed but error returned. Error message: %s Error type: %s", err.Error(), reflect.TypeOf(err))
@@ -452,11 +677,13 @@ func TestService_Login2(t *testing.T) {
 	defer controller.Finish()
 	securityCode.Action = security_code_login_action
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(domain.Password{}, errors2.EntityNotFound{})
 	generateUserCertError = true
 	patchGenerateUserCert()
 	defer monkey.UnpatchAll()
-	_, err := core.Login(user.Phone, securityCodeRaw)
+	_, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected method Login to return error but no error returned")
@@ -472,7 +699,7 @@ func TestService_Login3(t *testing.T) {
 	securityCode.Action = security_code_login_action
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, dummyError)
 
-	_, err := core.Login(user.Phone, securityCodeRaw)
+	_, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected method login to return error but no error returned")
@@ -484,11 +711,430 @@ func TestService_Login4(t *testing.T) {
 	defer controller.Finish()
 	securityCode.Action = security_code_login_action
 	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
 	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(domain.User{}, dummyError)
 
-	_, err := core.Login(user.Phone, securityCodeRaw)
+	_, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
 	switch err == nil {
 	case true:
 		t.Errorf("Expected method login to return error but no error returned")
 	}
 }
+
+/**
+ * Test case for replaying a login security code. Second login with the same code must fail
+ */
+func TestService_Login8(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	expectConsumableSecurityCode(user.Phone, securityCode)
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(domain.User{}, errors2.EntityNotFound{})
+
+	_, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Fatalf("Expected the first login to pass security code verification. Error message: %v", err)
+	}
+	_, err = core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from Login. Expected replayed security code to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Test case for a security code that is consumed by a concurrent request after it is verified
+ */
+func TestService_Login9(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(false, nil)
+
+	_, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from Login. Expected Login to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Stores the security code of key until it is consumed so that consumed codes are not found anymore
+ */
+func expectConsumableSecurityCode(key string, code domain.SecurityCode) {
+	isDeleted := false
+	repositoryMock.EXPECT().GetSecurityCode(key).DoAndReturn(func(_ string) (domain.SecurityCode, error) {
+		switch isDeleted {
+		case true:
+			return domain.SecurityCode{}, errors2.EntityNotFound{}
+		}
+		return code, nil
+	}).AnyTimes()
+	repositoryMock.EXPECT().ConsumeSecurityCode(key, code.SecurityCode).DoAndReturn(func(_ string, _ string) (bool, error) {
+		isDeleted = true
+		return true, nil
+	})
+}
+
+/**
+ * Test case for incorrect security code
+ */
+func TestService_VerifySecurityCode(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().IncrementSecurityCodeAttempts(user.Phone).Return(1, nil)
+
+	err := core.VerifySecurityCode(user.Phone, "000000", security_code_login_action)
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from VerifySecurityCode. Expected VerifySecurityCode to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Test case for reaching maximum attempts. Security code must be invalidated
+ */
+func TestService_VerifySecurityCode2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	securityCode.Attempts = dummyConfigs.SecurityCodeMaxAttempts - 1
+	defer func() { securityCode.Attempts = 0 }()
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().IncrementSecurityCodeAttempts(user.Phone).Return(dummyConfigs.SecurityCodeMaxAttempts, nil)
+	repositoryMock.EXPECT().DeleteSecurityCode(user.Phone).Return(nil)
+
+	err := core.VerifySecurityCode(user.Phone, "000000", security_code_login_action)
+	switch errors.As(err, &SecurityCodeAttemptsExceeded{}) {
+	case false:
+		t.Errorf("Proper error not returned from VerifySecurityCode. Expected VerifySecurityCode to return SecurityCodeAttemptsExceeded error")
+	}
+}
+
+/**
+ * Test case for a security code that has already exceeded maximum attempts. Even the correct code must be rejected
+ */
+func TestService_VerifySecurityCode3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	securityCode.Attempts = dummyConfigs.SecurityCodeMaxAttempts
+	defer func() { securityCode.Attempts = 0 }()
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+
+	err := core.VerifySecurityCode(user.Phone, securityCodeRaw, security_code_login_action)
+	switch errors.As(err, &SecurityCodeAttemptsExceeded{}) {
+	case false:
+		t.Errorf("Proper error not returned from VerifySecurityCode. Expected VerifySecurityCode to return SecurityCodeAttemptsExceeded error")
+	}
+}
+
+/**
+ * Test case for internal failure while counting attempts
+ */
+func TestService_VerifySecurityCode4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().IncrementSecurityCodeAttempts(user.Phone).Return(0, dummyError)
+
+	err := core.VerifySecurityCode(user.Phone, "000000", security_code_login_action)
+	switch errors.As(err, &errors2.InternalError{}) {
+	case false:
+		t.Errorf("Proper error not returned from VerifySecurityCode. Expected VerifySecurityCode to return InternalError error")
+	}
+}
+
+/**
+ * Test case for keys that are not phone numbers. They must be rejected before any security code is looked up
+ */
+func TestService_VerifySecurityCode5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	for _, phone := range []string{"email:" + user.Id, "", "+12ab345678", "0000000000"} {
+		err := core.VerifySecurityCode(phone, securityCodeRaw, security_code_login_action)
+		switch errors.As(err, &PhoneNotValid{}) {
+		case false:
+			t.Errorf("Proper error not returned from VerifySecurityCode. Expected VerifySecurityCode to return PhoneNotValid error for %q", phone)
+		}
+	}
+}
+
+/**
+ * Normal test case for security code request limits
+ */
+func TestService_checkSecurityCodeRequestLimits(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetSecurityCodeRequests(phone_rate_limit_prefix+user.Phone, gomock.Any()).Return([]time.Time{time.Now()}, nil)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(ip_rate_limit_prefix+dummyIp, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(phone_rate_limit_prefix+user.Phone, gomock.Any(), dummyConfigs.SecurityCodePhoneRateLimit.Window)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(ip_rate_limit_prefix+dummyIp, gomock.Any(), dummyConfigs.SecurityCodeIpRateLimit.Window)
+
+	err := core.checkSecurityCodeRequestLimits(user.Phone, dummyIp)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected checkSecurityCodeRequestLimits to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for resend cooldown
+ */
+func TestService_checkSecurityCodeRequestLimits2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	recentSecurityCode := securityCode
+	recentSecurityCode.CreatedAt = time.Now().Add(-10 * time.Second)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(recentSecurityCode, nil)
+
+	err := core.checkSecurityCodeRequestLimits(user.Phone, dummyIp)
+	tooManyRequests := TooManyRequests{}
+	switch errors.As(err, &tooManyRequests) {
+	case false:
+		t.Errorf("Proper error not returned from checkSecurityCodeRequestLimits. Expected checkSecurityCodeRequestLimits to return TooManyRequests error")
+	}
+	switch tooManyRequests.RetryAfter <= 0 || tooManyRequests.RetryAfter > dummyConfigs.SecurityCodeResendCooldown {
+	case true:
+		t.Errorf("Expected retry after to be in cooldown range but got %v", tooManyRequests.RetryAfter)
+	}
+}
+
+/**
+ * Test case for reaching phone rate limit. Retry after must be calculated from the oldest request
+ */
+func TestService_checkSecurityCodeRequestLimits3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	now := time.Now()
+	requests := []time.Time{now.Add(-10 * time.Minute), now.Add(-50 * time.Minute), now.Add(-5 * time.Minute)}
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetSecurityCodeRequests(phone_rate_limit_prefix+user.Phone, gomock.Any()).Return(requests, nil)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(ip_rate_limit_prefix+dummyIp, gomock.Any()).Return(nil, nil).AnyTimes()
+
+	err := core.checkSecurityCodeRequestLimits(user.Phone, dummyIp)
+	tooManyRequests := TooManyRequests{}
+	switch errors.As(err, &tooManyRequests) {
+	case false:
+		t.Errorf("Proper error not returned from checkSecurityCodeRequestLimits. Expected checkSecurityCodeRequestLimits to return TooManyRequests error")
+	}
+	switch tooManyRequests.RetryAfter <= 9*time.Minute || tooManyRequests.RetryAfter > 10*time.Minute {
+	case true:
+		t.Errorf("Expected retry after to be about 10 minutes but got %v", tooManyRequests.RetryAfter)
+	}
+}
+
+/**
+ * Test case for internal failure
+ */
+func TestService_checkSecurityCodeRequestLimits4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, dummyError)
+
+	err := core.checkSecurityCodeRequestLimits(user.Phone, dummyIp)
+	switch errors.As(err, &errors2.InternalError{}) {
+	case false:
+		t.Errorf("Proper error not returned from checkSecurityCodeRequestLimits. Expected checkSecurityCodeRequestLimits to return InternalError error")
+	}
+}
+
+/**
+ * Test case for invalid public key. Security code must not be checked
+ */
+func TestService_Login5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	_, err := core.Login(user.Phone, securityCodeRaw, []byte("invalid public key"), nil, dummySession)
+	switch errors.As(err, &PublicKeyNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from Login. Expected Login to return PublicKeyNotValid error")
+	}
+}
+
+/**
+ * Normal test case for login with a certificate request
+ */
+func TestService_Login6(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(domain.Password{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().RecordCertificate(dummyCertificate)
+	repositoryMock.EXPECT().NewSession(domain.Session{
+		UserId:    user.Id,
+		Serial:    dummyCertificate.Serial,
+		Device:    dummySession.Device,
+		Ip:        dummySession.Ip,
+		CreatedAt: dummyCertificate.IssuedAt,
+		ExpiresAt: dummyCertificate.ExpiresAt,
+	})
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any())
+	generateUserCertError = false
+	patchGenerateUserCert()
+	defer monkey.UnpatchAll()
+
+	cert, err := core.Login(user.Phone, securityCodeRaw, nil, dummyCertificateRequest, dummySession)
+	switch err != nil || string(cert) != string(dummyUserCert) {
+	case true:
+		t.Errorf("Expected method Login to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for certificate request with invalid signature
+ */
+func TestService_parseClientKey(t *testing.T) {
+	block, _ := pem.Decode(dummyCertificateRequest)
+	tampered := append([]byte{}, block.Bytes...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	_, err := parseClientKey(nil, tampered)
+	switch errors.As(err, &CertificateRequestNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from parseClientKey. Expected parseClientKey to return CertificateRequestNotValid error")
+	}
+}
+
+/**
+ * Test case for weak keys
+ */
+func TestService_parseClientKey2(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	publicKey, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+
+	_, err := parseClientKey(publicKey, nil)
+	switch errors.As(err, &PublicKeyTooWeak{}) {
+	case false:
+		t.Errorf("Proper error not returned from parseClientKey. Expected parseClientKey to return PublicKeyTooWeak error")
+	}
+}
+
+/**
+ * Normal test case. Issued certificate must only be usable for client authentication and expire after configured validity
+ */
+func TestService_generateUserCert(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	certGenMock.EXPECT().NewCertificate(gomock.Any(), &key.PublicKey).DoAndReturn(func(cert *x509.Certificate, _ interface{}) ([]byte, error) {
+		switch cert.Subject.CommonName != user.Id {
+		case true:
+			t.Errorf("Expected certificate common name to be %s but got %s", user.Id, cert.Subject.CommonName)
+		}
+		switch len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageClientAuth || cert.IsCA {
+		case true:
+			t.Errorf("Expected certificate to be a client authentication certificate")
+		}
+		validity := cert.NotAfter.Sub(time.Now())
+		switch validity <= 0 || validity > dummyConfigs.CertificateValidity {
+		case true:
+			t.Errorf("Expected certificate to be valid for %v but got %v", dummyConfigs.CertificateValidity, validity)
+		}
+		switch len(cert.URIs) == 0 || cert.URIs[0].String() != "tel:"+user.Phone {
+		case true:
+			t.Errorf("Expected certificate to contain phone number of the user")
+		}
+		cert.SerialNumber = big.NewInt(1234567890)
+		return dummyUserCert, nil
+	})
+
+	cert, certificate, err := core.generateUserCert(user, &key.PublicKey)
+	switch certificate.Serial != "1234567890" || certificate.UserId != user.Id {
+	case true:
+		t.Errorf("Expected generateUserCert to return the record of issued certificate")
+	}
+	switch err != nil || string(cert) != string(dummyUserCert) {
+	case true:
+		t.Errorf("Expected generateUserCert to succeed but error returned. Error message: %v", err)
+	}
+}
+
+func TestService_GetUserById(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+
+	returnedUser, err := core.GetUserById(selfViewer, user.Id)
+	switch err != nil || returnedUser != user {
+	case true:
+		t.Errorf("Expected GetUserById to return the user. Error: %v", err)
+	}
+}
+
+/**
+ * Test case for users that do not exist
+ */
+func TestService_GetUserById2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(domain.User{}, errors2.EntityNotFound{})
+
+	_, err := core.GetUserById(selfViewer, user.Id)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from GetUserById. Expected GetUserById to return UserNotFound error")
+	}
+}
+
+/**
+ * Test case for repository failures
+ */
+func TestService_GetUserByPhone(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(domain.User{}, errors2.InternalError{})
+
+	_, err := core.GetUserByPhone(selfViewer, user.Phone)
+	switch errors.As(err, &errors2.InternalError{}) {
+	case false:
+		t.Errorf("Proper error not returned from GetUserByPhone. Expected GetUserByPhone to return InternalError error")
+	}
+}
+
+/**
+ * Test case for partial results. Duplicate and differently cased ids must be looked up once
+ */
+func TestService_GetUsersByIds(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	missingId := "11111111-1111-1111-1111-111111111111"
+	upperId := "AAAAAAAA-0000-0000-0000-000000000000"
+	upperUser := user
+	upperUser.Id = strings.ToLower(upperId)
+	repositoryMock.EXPECT().GetUsersByIds([]string{user.Id, missingId, upperUser.Id}).Return(map[string]domain.User{
+		user.Id:      user,
+		upperUser.Id: upperUser,
+	}, nil)
+
+	users, err := core.GetUsersByIds(Viewer{IsAdmin: true}, []string{user.Id, missingId, user.Id, upperId})
+	switch err != nil || len(users) != 2 || users[user.Id] != user || users[upperId] != upperUser {
+	case true:
+		t.Errorf("Expected GetUsersByIds to return found users keyed by requested ids. Returned users: %+v, error: %v", users, err)
+	}
+}
+
+/**
+ * Test case for batches larger than UsersBatchMaxSize
+ */
+func TestService_GetUsersByIds2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	_, err := core.GetUsersByIds(selfViewer, []string{"1", "2", "3", "4"})
+	switch errors.As(err, &UsersBatchTooLarge{}) {
+	case false:
+		t.Errorf("Proper error not returned from GetUsersByIds. Expected GetUsersByIds to return UsersBatchTooLarge error")
+	}
+}
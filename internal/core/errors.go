@@ -2,6 +2,7 @@ package core
 
 import (
 	"github.com/zytell3301/tg-globals/errors"
+	"time"
 )
 
 type UserAlreadyExists struct {
@@ -28,6 +29,123 @@ type SecurityCodeActionDoesNotMatch struct {
 	errors.Derror
 }
 
+type SecurityCodeDeliveryFailed struct {
+	errors.Derror
+}
+
+type SecurityCodeAttemptsExceeded struct {
+	errors.Derror
+}
+
+type PublicKeyNotValid struct {
+	errors.Derror
+}
+
+type CertificateRequestNotValid struct {
+	errors.Derror
+}
+
+type PublicKeyTooWeak struct {
+	errors.Derror
+}
+
+type CertificateNotValid struct {
+	errors.Derror
+}
+
+type CertificateNotFound struct {
+	errors.Derror
+}
+
+type SessionNotFound struct {
+	errors.Derror
+}
+
+type AccountDeletionNotScheduled struct {
+	errors.Derror
+}
+
+type AccountTTLNotValid struct {
+	errors.Derror
+}
+
+type PasswordNotValid struct {
+	errors.Derror
+}
+
+type LoginTokenNotValid struct {
+	errors.Derror
+}
+
+type PasswordNotQualified struct {
+	errors.Derror
+}
+
+type EmailNotValid struct {
+	errors.Derror
+}
+
+type PasswordAlreadySet struct {
+	errors.Derror
+}
+
+type PasswordNotSet struct {
+	errors.Derror
+}
+
+type RecoveryEmailNotSet struct {
+	errors.Derror
+}
+
+type RecoveryEmailAlreadyVerified struct {
+	errors.Derror
+}
+
+type UsersBatchTooLarge struct {
+	errors.Derror
+}
+
+type SearchQueryNotValid struct {
+	errors.Derror
+}
+
+type PrivacyRuleNotValid struct {
+	errors.Derror
+}
+
+type ContactsBatchTooLarge struct {
+	errors.Derror
+}
+
+type PhoneNotValid struct {
+	errors.Derror
+}
+
+/**
+ * Login must be completed by CheckPassword with LoginToken. Hint is the password hint of the user
+ */
+type PasswordRequired struct {
+	errors.Derror
+	LoginToken string
+	Hint       string
+}
+
+/**
+ * Field is the name of the profile field that is not valid
+ */
+type ProfileNotValid struct {
+	errors.Derror
+	Field string
+}
+
+/**
+ * RetryAfter indicates the time that caller must wait before sending the request again
+ */
+type TooManyRequests struct {
+	errors.Derror
+	RetryAfter time.Duration
+}
+
 var (
 	UserAlreadyExistsError = UserAlreadyExists{
 		errors.Derror{
@@ -65,4 +183,160 @@ var (
 			Code:    8,
 		},
 	}
+	SecurityCodeDeliveryFailedError = SecurityCodeDeliveryFailed{
+		errors.Derror{
+			Message: "security code could not be delivered",
+			Code:    9,
+		},
+	}
+	SecurityCodeAttemptsExceededError = SecurityCodeAttemptsExceeded{
+		errors.Derror{
+			Message: "maximum security code attempts exceeded. request a new security code",
+			Code:    10,
+		},
+	}
+	TooManyRequestsError = TooManyRequests{
+		Derror: errors.Derror{
+			Message: "too many requests. try again later",
+			Code:    11,
+		},
+	}
+	PublicKeyNotValidError = PublicKeyNotValid{
+		errors.Derror{
+			Message: "public key is not valid",
+			Code:    12,
+		},
+	}
+	CertificateRequestNotValidError = CertificateRequestNotValid{
+		errors.Derror{
+			Message: "certificate request is malformed or its signature is not valid",
+			Code:    13,
+		},
+	}
+	PublicKeyTooWeakError = PublicKeyTooWeak{
+		errors.Derror{
+			Message: "public key is too weak. use RSA 2048 bits or stronger, ECDSA P-256 or stronger or Ed25519 keys",
+			Code:    14,
+		},
+	}
+	CertificateNotValidError = CertificateNotValid{
+		errors.Derror{
+			Message: "certificate is not valid",
+			Code:    15,
+		},
+	}
+	CertificateNotFoundError = CertificateNotFound{
+		errors.Derror{
+			Message: "certificate not found",
+			Code:    16,
+		},
+	}
+	SessionNotFoundError = SessionNotFound{
+		errors.Derror{
+			Message: "session not found",
+			Code:    17,
+		},
+	}
+	ProfileNotValidError = ProfileNotValid{
+		Derror: errors.Derror{
+			Message: "profile field is not valid",
+			Code:    18,
+		},
+	}
+	AccountDeletionNotScheduledError = AccountDeletionNotScheduled{
+		errors.Derror{
+			Message: "account deletion is not scheduled",
+			Code:    19,
+		},
+	}
+	AccountTTLNotValidError = AccountTTLNotValid{
+		errors.Derror{
+			Message: "account ttl is not valid. it must be one of 30, 90, 180 or 365 days",
+			Code:    20,
+		},
+	}
+	PasswordRequiredError = PasswordRequired{
+		Derror: errors.Derror{
+			Message: "two-step verification is enabled. login must be completed with the password",
+			Code:    21,
+		},
+	}
+	PasswordNotValidError = PasswordNotValid{
+		errors.Derror{
+			Message: "password is incorrect",
+			Code:    22,
+		},
+	}
+	LoginTokenNotValidError = LoginTokenNotValid{
+		errors.Derror{
+			Message: "login token is not valid or expired. login again",
+			Code:    23,
+		},
+	}
+	PasswordNotQualifiedError = PasswordNotQualified{
+		errors.Derror{
+			Message: "password must be 8 to 72 bytes and hint must not contain the password",
+			Code:    24,
+		},
+	}
+	EmailNotValidError = EmailNotValid{
+		errors.Derror{
+			Message: "email is not valid",
+			Code:    25,
+		},
+	}
+	PasswordAlreadySetError = PasswordAlreadySet{
+		errors.Derror{
+			Message: "password is already set",
+			Code:    26,
+		},
+	}
+	PasswordNotSetError = PasswordNotSet{
+		errors.Derror{
+			Message: "password is not set",
+			Code:    27,
+		},
+	}
+	RecoveryEmailNotSetError = RecoveryEmailNotSet{
+		errors.Derror{
+			Message: "recovery email is not set or not verified",
+			Code:    28,
+		},
+	}
+	RecoveryEmailAlreadyVerifiedError = RecoveryEmailAlreadyVerified{
+		errors.Derror{
+			Message: "recovery email is already verified",
+			Code:    29,
+		},
+	}
+	UsersBatchTooLargeError = UsersBatchTooLarge{
+		errors.Derror{
+			Message: "number of requested users exceeds the maximum batch size",
+			Code:    30,
+		},
+	}
+	SearchQueryNotValidError = SearchQueryNotValid{
+		errors.Derror{
+			Message: "search query must contain a word with at least 2 characters",
+			Code:    31,
+		},
+	}
+	PrivacyRuleNotValidError = PrivacyRuleNotValid{
+		errors.Derror{
+			Message: "privacy rule is not valid. rule or visibility is unknown or exception lists are too large or overlapping",
+			Code:    32,
+		},
+	}
+	ContactsBatchTooLargeError = ContactsBatchTooLarge{
+		errors.Derror{
+			Message: "too many contacts are imported at once",
+			Code:    33,
+		},
+	}
+	PhoneNotValidError = PhoneNotValid{
+		errors.Derror{
+			Message: "phone number must be in international format",
+			Code:    34,
+		},
+	}
 )
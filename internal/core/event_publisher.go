@@ -0,0 +1,10 @@
+package core
+
+import "github.com/zytell3301/tg-users-service/internal/domain"
+
+/**
+ * EventPublisher delivers events of users service to other services like messages and chats services
+ */
+type EventPublisher interface {
+	PublishUserDeleted(event domain.UserDeleted) error
+}
@@ -0,0 +1,10 @@
+package core
+
+/**
+ * MailSender delivers plain security codes to email addresses.
+ * Action is one of email security code actions (VERIFY_RECOVERY_EMAIL, RECOVER_PASSWORD) so that
+ * implementations can choose a proper message for each action.
+ */
+type MailSender interface {
+	SendMail(email string, code string, action string) error
+}
@@ -0,0 +1,360 @@
+package core
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	errors2 "errors"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"github.com/zytell3301/tg-users-service/pkg/CertGen"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	password_min_length = 8
+	// bcrypt ignores the bytes after 72th byte
+	password_max_length      = 72
+	password_hint_max_length = 64
+)
+
+/**
+ * Prefix of rate limit keys of failed password checks
+ */
+const password_rate_limit_prefix = "password:"
+
+/**
+ * Number of random bytes of login tokens
+ */
+const login_token_length = 32
+
+/**
+ * Stores a pending login for the key of the client and returns PasswordRequired error with the login token.
+ * Only the hash of the login token is stored.
+ * Returned errors:
+ * 1-PasswordRequired
+ * 2-InternalError
+ */
+func (s Service) newPendingLogin(user domain.User, key interface{}, session domain.Session, password domain.Password) error {
+	publicKey, err := x509.MarshalPKIXPublicKey(key)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	token, err := generateLoginToken()
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	err = s.repository.RecordPendingLogin(domain.PendingLogin{
+		TokenHash: hashLoginToken(token),
+		UserId:    user.Id,
+		PublicKey: publicKey,
+		Device:    session.Device,
+		Ip:        session.Ip,
+		CreatedAt: time.Now(),
+	}, s.configs.PendingLoginValidity)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return PasswordRequired{
+		LoginToken: token,
+		Hint:       password.Hint,
+	}
+}
+
+/**
+ * Completes a login that is started by Login if the password is correct. Login token can only be used once.
+ * Returned errors:
+ * 1-InternalError
+ * 2-LoginTokenNotValid
+ * 3-PasswordNotValid
+ * 4-TooManyRequests
+ * 5-UserNotFound
+ */
+func (s Service) CheckPassword(loginToken string, password string) ([]byte, error) {
+	login, err := s.getPendingLogin(loginToken)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	_, err = s.verifyPassword(login.UserId, password)
+	switch {
+	case errors2.As(err, &PasswordNotSet{}):
+		return nil, LoginTokenNotValid{}
+	case errors2.As(err, &PasswordNotValid{}) || errors2.As(err, &TooManyRequests{}):
+		return nil, err
+	case err != nil:
+		return nil, errors.InternalError{}
+	}
+	return s.completePendingLogin(login)
+}
+
+/**
+ * Returned errors:
+ * 1-InternalError
+ * 2-LoginTokenNotValid
+ */
+func (s Service) getPendingLogin(loginToken string) (domain.PendingLogin, error) {
+	login, err := s.repository.GetPendingLogin(hashLoginToken(loginToken))
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case true:
+			return domain.PendingLogin{}, LoginTokenNotValid{}
+		}
+		return domain.PendingLogin{}, errors.InternalError{}
+	}
+	switch time.Since(login.CreatedAt) > s.configs.PendingLoginValidity {
+	case true:
+		return domain.PendingLogin{}, LoginTokenNotValid{}
+	}
+	return login, nil
+}
+
+/**
+ * Deletes the pending login so that its token can not be used again and issues a certificate for its key.
+ * Certificate is only issued by the call that deletes the pending login, so concurrent completions of a login
+ * token never issue two certificates.
+ * Returned errors:
+ * 1-InternalError
+ * 2-LoginTokenNotValid
+ * 3-UserNotFound
+ */
+func (s Service) completePendingLogin(login domain.PendingLogin) ([]byte, error) {
+	isDeleted, err := s.repository.DeletePendingLogin(login.TokenHash)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	switch isDeleted {
+	case false:
+		return nil, LoginTokenNotValid{}
+	}
+	user, err := s.repository.GetUserById(login.UserId)
+	switch err != nil {
+	case true:
+		return nil, userLookupError(err)
+	}
+	key, err := CertGen.ParsePublicKey(login.PublicKey)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	return s.issueLogin(user, key, domain.Session{
+		Device: login.Device,
+		Ip:     login.Ip,
+	})
+}
+
+/**
+ * Enables two-step verification of the user. Hint and recovery email are optional.
+ * If recovery email is set, a security code is sent to it that must be passed to VerifyRecoveryEmail.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotQualified
+ * 3-EmailNotValid
+ * 4-PasswordAlreadySet
+ */
+func (s Service) SetPassword(userId string, password string, hint string, recoveryEmail string) error {
+	hint, recoveryEmail, err := qualifyPassword(password, hint, recoveryEmail)
+	switch err != nil {
+	case true:
+		return err
+	}
+	_, err = s.repository.GetPassword(userId)
+	switch {
+	case err == nil:
+		return PasswordAlreadySet{}
+	case !errors2.As(err, &errors.EntityNotFound{}):
+		return errors.InternalError{}
+	}
+	return s.storePassword(domain.Password{UserId: userId}, password, hint, recoveryEmail)
+}
+
+/**
+ * Replaces password, hint and recovery email of the user if the current password is correct.
+ * A new recovery email must be verified again by VerifyRecoveryEmail.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotQualified
+ * 3-EmailNotValid
+ * 4-PasswordNotSet
+ * 5-PasswordNotValid
+ * 6-TooManyRequests
+ */
+func (s Service) ChangePassword(userId string, currentPassword string, newPassword string, hint string, recoveryEmail string) error {
+	hint, recoveryEmail, err := qualifyPassword(newPassword, hint, recoveryEmail)
+	switch err != nil {
+	case true:
+		return err
+	}
+	current, err := s.verifyPassword(userId, currentPassword)
+	switch err != nil {
+	case true:
+		return err
+	}
+	return s.storePassword(current, newPassword, hint, recoveryEmail)
+}
+
+/**
+ * Disables two-step verification of the user if the current password is correct.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotSet
+ * 3-PasswordNotValid
+ * 4-TooManyRequests
+ */
+func (s Service) DisablePassword(userId string, currentPassword string) error {
+	_, err := s.verifyPassword(userId, currentPassword)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.repository.DeletePassword(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Replaces current password of the user. Recovery email stays verified only if it is not changed.
+ * Otherwise a verification code is sent to the new recovery email. Failures of sending the code are not
+ * returned because the password is already stored and the code can be requested again by RequestRecoveryEmailCode.
+ */
+func (s Service) storePassword(current domain.Password, password string, hint string, recoveryEmail string) error {
+	verified := recoveryEmail != "" && recoveryEmail == current.RecoveryEmail && current.RecoveryEmailVerified
+	switch recoveryEmail != current.RecoveryEmail {
+	case true:
+		// Code of the previous recovery email must not verify the new one
+		err := s.repository.DeleteEmailCode(current.UserId)
+		switch err != nil {
+		case true:
+			return errors.InternalError{}
+		}
+	}
+	err := s.repository.SetPassword(domain.Password{
+		UserId:                current.UserId,
+		Hash:                  hashExpression(password),
+		Hint:                  hint,
+		RecoveryEmail:         recoveryEmail,
+		RecoveryEmailVerified: verified,
+		UpdatedAt:             time.Now(),
+	})
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	switch recoveryEmail != "" && !verified {
+	case true:
+		err = s.requestEmailCode(current.UserId, recoveryEmail, "", security_code_verify_recovery_email_action)
+		switch errors2.As(err, &errors.InternalError{}) {
+		case true:
+			s.reportError("sending recovery email verification code of user "+current.UserId, err)
+		}
+	}
+	return nil
+}
+
+/**
+ * Checks the password of the user. Failed checks are limited by PasswordRateLimit.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotSet
+ * 3-PasswordNotValid
+ * 4-TooManyRequests
+ */
+func (s Service) verifyPassword(userId string, password string) (domain.Password, error) {
+	now := time.Now()
+	key := password_rate_limit_prefix + userId
+	err := s.checkRateLimit(key, s.configs.PasswordRateLimit, now)
+	switch err != nil {
+	case true:
+		return domain.Password{}, err
+	}
+	stored, err := s.getPassword(userId)
+	switch err != nil {
+	case true:
+		return domain.Password{}, err
+	}
+	switch checkHashMatch(password, stored.Hash) {
+	case false:
+		switch s.configs.PasswordRateLimit.Requests > 0 {
+		case true:
+			err = s.repository.RecordSecurityCodeRequest(key, now, s.configs.PasswordRateLimit.Window)
+			switch err != nil {
+			case true:
+				return domain.Password{}, errors.InternalError{}
+			}
+		}
+		return domain.Password{}, PasswordNotValid{}
+	}
+	return stored, nil
+}
+
+/**
+ * Checks password rules and returns trimmed hint and normalized recovery email.
+ * Returned errors:
+ * 1-PasswordNotQualified
+ * 2-EmailNotValid
+ */
+func qualifyPassword(password string, hint string, recoveryEmail string) (string, string, error) {
+	hint = strings.TrimSpace(hint)
+	switch len(password) < password_min_length || len(password) > password_max_length || utf8.RuneCountInString(hint) > password_hint_max_length {
+	case true:
+		return "", "", PasswordNotQualified{}
+	}
+	switch hint != "" && strings.Contains(strings.ToLower(hint), strings.ToLower(password)) {
+	case true:
+		return "", "", PasswordNotQualified{}
+	}
+	recoveryEmail, err := normalizeEmail(recoveryEmail)
+	switch err != nil {
+	case true:
+		return "", "", err
+	}
+	return hint, recoveryEmail, nil
+}
+
+/**
+ * Empty email is valid. Otherwise email must be a bare address like user@example.com
+ */
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	switch email == "" {
+	case true:
+		return "", nil
+	}
+	address, err := mail.ParseAddress(email)
+	switch err != nil || address.Address != email {
+	case true:
+		return "", EmailNotValid{}
+	}
+	return address.Address, nil
+}
+
+func generateLoginToken() (string, error) {
+	token := make([]byte, login_token_length)
+	_, err := rand.Read(token)
+	switch err != nil {
+	case true:
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+/**
+ * Login tokens have enough entropy so a fast hash is sufficient
+ */
+func hashLoginToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
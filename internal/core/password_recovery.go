@@ -0,0 +1,239 @@
+package core
+
+import (
+	errors2 "errors"
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"strings"
+)
+
+/**
+ * Sends a new verification code to the recovery email of the user if it is not verified yet.
+ * Ip is the address of the caller and is used for rate limiting.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotSet
+ * 3-RecoveryEmailNotSet
+ * 4-RecoveryEmailAlreadyVerified
+ * 5-SecurityCodeDeliveryFailed
+ * 6-TooManyRequests
+ */
+func (s Service) RequestRecoveryEmailCode(userId string, ip string) error {
+	password, err := s.getPassword(userId)
+	switch err != nil {
+	case true:
+		return err
+	}
+	switch {
+	case password.RecoveryEmail == "":
+		return RecoveryEmailNotSet{}
+	case password.RecoveryEmailVerified:
+		return RecoveryEmailAlreadyVerified{}
+	}
+	return s.requestEmailCode(userId, password.RecoveryEmail, ip, security_code_verify_recovery_email_action)
+}
+
+/**
+ * Marks recovery email of the user as verified if the security code sent to it is correct.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotSet
+ * 3-RecoveryEmailNotSet
+ * 4-SecurityCodeNotValid
+ * 5-SecurityCodeActionDoesNotMatch
+ * 6-SecurityCodeAttemptsExceeded
+ */
+func (s Service) VerifyRecoveryEmail(userId string, securityCode string) error {
+	password, err := s.getPassword(userId)
+	switch err != nil {
+	case true:
+		return err
+	}
+	switch password.RecoveryEmail == "" {
+	case true:
+		return RecoveryEmailNotSet{}
+	}
+	err = s.consumeEmailCode(userId, securityCode, security_code_verify_recovery_email_action)
+	switch err != nil {
+	case true:
+		return err
+	}
+	password.RecoveryEmailVerified = true
+	err = s.repository.SetPassword(password)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Sends a recovery code to the verified recovery email of the user of a login that waits for the password.
+ * Masked recovery email is returned so that the user knows where to look for the code.
+ * Returned errors:
+ * 1-InternalError
+ * 2-LoginTokenNotValid
+ * 3-RecoveryEmailNotSet
+ * 4-SecurityCodeDeliveryFailed
+ * 5-TooManyRequests
+ */
+func (s Service) RequestPasswordRecovery(loginToken string, ip string) (string, error) {
+	login, err := s.getPendingLogin(loginToken)
+	switch err != nil {
+	case true:
+		return "", err
+	}
+	password, err := s.getPassword(login.UserId)
+	switch {
+	case errors2.As(err, &PasswordNotSet{}):
+		return "", LoginTokenNotValid{}
+	case err != nil:
+		return "", err
+	}
+	switch password.RecoveryEmail == "" || !password.RecoveryEmailVerified {
+	case true:
+		return "", RecoveryEmailNotSet{}
+	}
+	err = s.requestEmailCode(login.UserId, password.RecoveryEmail, ip, security_code_recover_password_action)
+	switch err != nil {
+	case true:
+		return "", err
+	}
+	return maskEmail(password.RecoveryEmail), nil
+}
+
+/**
+ * Resets the password of the user of a pending login if the recovery code is correct and completes the login.
+ * Empty newPassword disables two-step verification. All other sessions of the user are terminated.
+ * Returned errors:
+ * 1-InternalError
+ * 2-LoginTokenNotValid
+ * 3-PasswordNotQualified
+ * 4-SecurityCodeNotValid
+ * 5-SecurityCodeActionDoesNotMatch
+ * 6-SecurityCodeAttemptsExceeded
+ * 7-UserNotFound
+ */
+func (s Service) RecoverPassword(loginToken string, securityCode string, newPassword string, hint string) ([]byte, error) {
+	login, err := s.getPendingLogin(loginToken)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	password, err := s.getPassword(login.UserId)
+	switch {
+	case errors2.As(err, &PasswordNotSet{}):
+		return nil, LoginTokenNotValid{}
+	case err != nil:
+		return nil, err
+	}
+	switch newPassword != "" {
+	case true:
+		hint, _, err = qualifyPassword(newPassword, hint, "")
+		switch err != nil {
+		case true:
+			return nil, err
+		}
+	}
+	err = s.consumeEmailCode(login.UserId, securityCode, security_code_recover_password_action)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	switch newPassword != "" {
+	case true:
+		err = s.storePassword(password, newPassword, hint, password.RecoveryEmail)
+	default:
+		err = s.repository.DeletePassword(login.UserId)
+	}
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	sessions, err := s.repository.GetUserSessions(login.UserId)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	err = s.terminateSessions(sessions)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	return s.completePendingLogin(login)
+}
+
+/**
+ * Returned errors:
+ * 1-InternalError
+ * 2-PasswordNotSet
+ */
+func (s Service) getPassword(userId string) (domain.Password, error) {
+	password, err := s.repository.GetPassword(userId)
+	switch err != nil {
+	case true:
+		switch errors2.As(err, &errors.EntityNotFound{}) {
+		case true:
+			return domain.Password{}, PasswordNotSet{}
+		}
+		return domain.Password{}, errors.InternalError{}
+	}
+	return password, nil
+}
+
+/**
+ * Creates a new security code for the user and sends it to the email.
+ * Only the hash of the code is stored and the plain code is handed to mail sender.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeDeliveryFailed
+ * 3-TooManyRequests
+ */
+func (s Service) requestEmailCode(userId string, email string, ip string, action string) error {
+	err := s.checkCodeRequestLimits(s.emailCodes(), userId, ip)
+	switch err != nil {
+	case true:
+		return err
+	}
+	code := generateSecurityCode()
+	err = s.repository.RecordEmailCode(domain.SecurityCode{
+		UserId:       userId,
+		Action:       action,
+		SecurityCode: hashExpression(code),
+	})
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	err = s.mailSender.SendMail(email, code, action)
+	switch err != nil {
+	case true:
+		s.reportError("sending email security code", err)
+		return SecurityCodeDeliveryFailed{}
+	}
+	return nil
+}
+
+/**
+ * Verifies an email security code of the user and deletes it so that it can not be used again.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SecurityCodeNotValid
+ * 3-SecurityCodeActionDoesNotMatch
+ * 4-SecurityCodeAttemptsExceeded
+ */
+func (s Service) consumeEmailCode(userId string, securityCode string, action string) error {
+	return s.consumeCode(s.emailCodes(), userId, securityCode, action)
+}
+
+/**
+ * Hides the local part of the email except its first character. For example john@example.com becomes j***@example.com
+ */
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	switch at < 1 {
+	case true:
+		return "***"
+	}
+	return email[:1] + "***" + email[at:]
+}
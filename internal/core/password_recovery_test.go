@@ -0,0 +1,142 @@
+package core
+
+import (
+	"bou.ke/monkey"
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+var recoveryEmail = "user@example.com"
+
+func newDummyPendingLogin(loginToken string) domain.PendingLogin {
+	return domain.PendingLogin{
+		TokenHash: hashLoginToken(loginToken),
+		UserId:    user.Id,
+		CreatedAt: time.Now(),
+	}
+}
+
+/**
+ * Sets expectations of sending an email security code of the user with given action
+ */
+func expectEmailCode(t *testing.T, action string) {
+	repositoryMock.EXPECT().GetEmailCode(user.Id).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetSecurityCodeRequests(email_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(email_rate_limit_prefix+user.Id, gomock.Any(), dummyConfigs.SecurityCodePhoneRateLimit.Window)
+	repositoryMock.EXPECT().RecordEmailCode(gomock.Any()).DoAndReturn(func(code domain.SecurityCode) error {
+		switch code.UserId != user.Id || code.Action != action {
+		case true:
+			t.Errorf("Email security code is not recorded properly. Recorded code: %+v", code)
+		}
+		return nil
+	})
+	mailSenderMock.EXPECT().SendMail(recoveryEmail, gomock.Any(), action)
+}
+
+func TestService_VerifyRecoveryEmail(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	password := newDummyPassword()
+	password.RecoveryEmail = recoveryEmail
+	emailCode := securityCode
+	emailCode.Phone = ""
+	emailCode.UserId = user.Id
+	emailCode.Action = security_code_verify_recovery_email_action
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(password, nil)
+	repositoryMock.EXPECT().GetEmailCode(user.Id).Return(emailCode, nil)
+	repositoryMock.EXPECT().ConsumeEmailCode(user.Id, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().SetPassword(gomock.Any()).DoAndReturn(func(stored domain.Password) error {
+		switch stored.RecoveryEmail != recoveryEmail || !stored.RecoveryEmailVerified || stored.Hash != password.Hash {
+		case true:
+			t.Errorf("Expected recovery email to be marked as verified. Stored password: %+v", stored)
+		}
+		return nil
+	})
+
+	err := core.VerifyRecoveryEmail(user.Id, securityCodeRaw)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected VerifyRecoveryEmail to succeed. Error: %v", err)
+	}
+}
+
+func TestService_RequestPasswordRecovery(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	password := newDummyPassword()
+	password.RecoveryEmail = recoveryEmail
+	password.RecoveryEmailVerified = true
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(newDummyPendingLogin(loginToken), nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(password, nil)
+	expectEmailCode(t, security_code_recover_password_action)
+	monkey.Patch(hashExpression, hashExpressionPatch)
+	defer monkey.UnpatchAll()
+
+	emailPattern, err := core.RequestPasswordRecovery(loginToken, "")
+	switch err != nil || emailPattern != "u***@example.com" {
+	case true:
+		t.Errorf("Expected RequestPasswordRecovery to send recovery code. Returned pattern: %s, error: %v", emailPattern, err)
+	}
+}
+
+/**
+ * Test case for recovery emails that are not verified
+ */
+func TestService_RequestPasswordRecovery2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	password := newDummyPassword()
+	password.RecoveryEmail = recoveryEmail
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(newDummyPendingLogin(loginToken), nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(password, nil)
+
+	_, err := core.RequestPasswordRecovery(loginToken, "")
+	switch errors.As(err, &RecoveryEmailNotSet{}) {
+	case false:
+		t.Errorf("Proper error not returned from RequestPasswordRecovery. Expected RequestPasswordRecovery to return RecoveryEmailNotSet error")
+	}
+}
+
+/**
+ * Test case for recovering without a new password. Password must be deleted, sessions terminated and login completed
+ */
+func TestService_RecoverPassword(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	login := newDummyPendingLogin(loginToken)
+	login.PublicKey = parsedDummyPublicKey()
+	password := newDummyPassword()
+	password.RecoveryEmail = recoveryEmail
+	password.RecoveryEmailVerified = true
+	recoveryCode := securityCode
+	recoveryCode.Action = security_code_recover_password_action
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(login, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(password, nil)
+	repositoryMock.EXPECT().GetEmailCode(user.Id).Return(recoveryCode, nil)
+	repositoryMock.EXPECT().ConsumeEmailCode(user.Id, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().DeletePassword(user.Id)
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(newDummySessions(), nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Any())
+	repositoryMock.EXPECT().DeleteSessions(user.Id, gomock.Any())
+	repositoryMock.EXPECT().DeletePendingLogin(hashLoginToken(loginToken)).Return(true, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().RecordCertificate(dummyCertificate)
+	repositoryMock.EXPECT().NewSession(gomock.Any())
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any())
+	generateUserCertError = false
+	patchGenerateUserCert()
+	defer monkey.UnpatchAll()
+
+	cert, err := core.RecoverPassword(loginToken, securityCodeRaw, "", "")
+	switch err != nil || string(cert) != string(dummyUserCert) {
+	case true:
+		t.Errorf("Expected RecoverPassword to complete the login. Error: %v", err)
+	}
+}
@@ -0,0 +1,279 @@
+package core
+
+import (
+	"bou.ke/monkey"
+	"encoding/pem"
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+	"time"
+)
+
+var passwordRaw = "correct horse battery"
+var passwordHash, _ = bcrypt.GenerateFromPassword([]byte(passwordRaw), bcrypt.MinCost)
+
+/**
+ * Returns DER encoded dummyPublicKey like the keys of pending logins
+ */
+func parsedDummyPublicKey() []byte {
+	block, _ := pem.Decode(dummyPublicKey)
+	return block.Bytes
+}
+
+func newDummyPassword() domain.Password {
+	return domain.Password{
+		UserId: user.Id,
+		Hash:   string(passwordHash),
+		Hint:   "horse",
+	}
+}
+
+/**
+ * Test case for users with two-step verification. Login must be suspended until the password is checked
+ */
+func TestService_Login7(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	securityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(securityCode, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	var tokenHash string
+	repositoryMock.EXPECT().RecordPendingLogin(gomock.Any(), dummyConfigs.PendingLoginValidity).DoAndReturn(func(login domain.PendingLogin, _ time.Duration) error {
+		tokenHash = login.TokenHash
+		switch login.UserId != user.Id || len(login.PublicKey) == 0 || login.Device != dummySession.Device {
+		case true:
+			t.Errorf("Pending login is not recorded properly. Recorded login: %+v", login)
+		}
+		return nil
+	})
+
+	cert, err := core.Login(user.Phone, securityCodeRaw, dummyPublicKey, nil, dummySession)
+	passwordRequired := PasswordRequired{}
+	switch cert == nil && errors.As(err, &passwordRequired) {
+	case false:
+		t.Errorf("Expected Login to return PasswordRequired error without certificate. Returned error: %v", err)
+		return
+	}
+	switch passwordRequired.Hint != newDummyPassword().Hint || hashLoginToken(passwordRequired.LoginToken) != tokenHash {
+	case true:
+		t.Errorf("PasswordRequired error does not contain the hint and login token. Returned error: %+v", passwordRequired)
+	}
+}
+
+/**
+ * Test case for completing a pending login with the correct password
+ */
+func TestService_CheckPassword(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(domain.PendingLogin{
+		TokenHash: hashLoginToken(loginToken),
+		UserId:    user.Id,
+		PublicKey: parsedDummyPublicKey(),
+		Device:    dummySession.Device,
+		Ip:        dummySession.Ip,
+		CreatedAt: time.Now(),
+	}, nil)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	repositoryMock.EXPECT().DeletePendingLogin(hashLoginToken(loginToken)).Return(true, nil)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().RecordCertificate(dummyCertificate)
+	repositoryMock.EXPECT().NewSession(gomock.Any())
+	repositoryMock.EXPECT().RecordAccountActivity(user.Id, gomock.Any())
+	generateUserCertError = false
+	patchGenerateUserCert()
+	defer monkey.UnpatchAll()
+
+	cert, err := core.CheckPassword(loginToken, passwordRaw)
+	switch err != nil || string(cert) != string(dummyUserCert) {
+	case true:
+		t.Errorf("Expected CheckPassword to issue certificate. Error: %v", err)
+	}
+}
+
+/**
+ * Test case for incorrect password. Failed check must be recorded for rate limiting
+ */
+func TestService_CheckPassword2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(domain.PendingLogin{
+		UserId:    user.Id,
+		CreatedAt: time.Now(),
+	}, nil)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(password_rate_limit_prefix+user.Id, gomock.Any(), dummyConfigs.PasswordRateLimit.Window)
+
+	_, err := core.CheckPassword(loginToken, "wrong password")
+	switch errors.As(err, &PasswordNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from CheckPassword. Expected CheckPassword to return PasswordNotValid error")
+	}
+}
+
+/**
+ * Test case for unknown or expired login tokens
+ */
+func TestService_CheckPassword3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetPendingLogin(gomock.Any()).Return(domain.PendingLogin{}, errors2.EntityNotFound{})
+
+	_, err := core.CheckPassword("login-token", passwordRaw)
+	switch errors.As(err, &LoginTokenNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from CheckPassword. Expected CheckPassword to return LoginTokenNotValid error")
+	}
+}
+
+/**
+ * Test case for too many failed password checks
+ */
+func TestService_CheckPassword4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetPendingLogin(gomock.Any()).Return(domain.PendingLogin{
+		UserId:    user.Id,
+		CreatedAt: time.Now(),
+	}, nil)
+	failures := make([]time.Time, dummyConfigs.PasswordRateLimit.Requests)
+	for i := range failures {
+		failures[i] = time.Now().Add(-time.Minute)
+	}
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(failures, nil)
+
+	_, err := core.CheckPassword("login-token", passwordRaw)
+	switch errors.As(err, &TooManyRequests{}) {
+	case false:
+		t.Errorf("Proper error not returned from CheckPassword. Expected CheckPassword to return TooManyRequests error")
+	}
+}
+
+/**
+ * Test case for a login token that is completed by a concurrent check meanwhile. No certificate must be issued
+ */
+func TestService_CheckPassword5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginToken := "login-token"
+	repositoryMock.EXPECT().GetPendingLogin(hashLoginToken(loginToken)).Return(domain.PendingLogin{
+		TokenHash: hashLoginToken(loginToken),
+		UserId:    user.Id,
+		CreatedAt: time.Now(),
+	}, nil)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	repositoryMock.EXPECT().DeletePendingLogin(hashLoginToken(loginToken)).Return(false, nil)
+	repositoryMock.EXPECT().GetUserById(gomock.Any()).Times(0)
+
+	_, err := core.CheckPassword(loginToken, passwordRaw)
+	switch errors.As(err, &LoginTokenNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from CheckPassword. Expected CheckPassword to return LoginTokenNotValid error")
+	}
+}
+
+func TestService_SetPassword(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(domain.Password{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().DeleteEmailCode(user.Id)
+	expectEmailCode(t, security_code_verify_recovery_email_action)
+	repositoryMock.EXPECT().SetPassword(gomock.Any()).DoAndReturn(func(password domain.Password) error {
+		switch password.UserId != user.Id || !checkHashMatch(passwordRaw, password.Hash) || password.Hint != "a hint" || password.RecoveryEmail != "user@example.com" || password.RecoveryEmailVerified {
+		case true:
+			t.Errorf("Password is not stored properly. Stored password: %+v", password)
+		}
+		return nil
+	})
+	monkey.Patch(hashExpression, func(expression string) string {
+		hash, _ := bcrypt.GenerateFromPassword([]byte(expression), bcrypt.MinCost)
+		return string(hash)
+	})
+	defer monkey.UnpatchAll()
+
+	err := core.SetPassword(user.Id, passwordRaw, " a hint ", "user@example.com")
+	switch err != nil {
+	case true:
+		t.Errorf("Expected SetPassword to succeed. Error: %v", err)
+	}
+}
+
+/**
+ * Test case for passwords that are already set
+ */
+func TestService_SetPassword2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+
+	err := core.SetPassword(user.Id, passwordRaw, "", "")
+	switch errors.As(err, &PasswordAlreadySet{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetPassword. Expected SetPassword to return PasswordAlreadySet error")
+	}
+}
+
+/**
+ * Test case for password and recovery email rules
+ */
+func TestService_SetPassword3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	err := core.SetPassword(user.Id, "short", "", "")
+	switch errors.As(err, &PasswordNotQualified{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetPassword. Expected SetPassword to return PasswordNotQualified error for short passwords")
+	}
+	err = core.SetPassword(user.Id, passwordRaw, "my password is "+passwordRaw, "")
+	switch errors.As(err, &PasswordNotQualified{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetPassword. Expected SetPassword to return PasswordNotQualified error for hints containing the password")
+	}
+	err = core.SetPassword(user.Id, passwordRaw, "", "not an email")
+	switch errors.As(err, &EmailNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetPassword. Expected SetPassword to return EmailNotValid error")
+	}
+}
+
+/**
+ * Test case for incorrect current password. Password must not be changed
+ */
+func TestService_ChangePassword(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(password_rate_limit_prefix+user.Id, gomock.Any(), dummyConfigs.PasswordRateLimit.Window)
+
+	err := core.ChangePassword(user.Id, "wrong password", "new password", "", "")
+	switch errors.As(err, &PasswordNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from ChangePassword. Expected ChangePassword to return PasswordNotValid error")
+	}
+}
+
+func TestService_DisablePassword(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetSecurityCodeRequests(password_rate_limit_prefix+user.Id, gomock.Any()).Return(nil, nil)
+	repositoryMock.EXPECT().GetPassword(user.Id).Return(newDummyPassword(), nil)
+	repositoryMock.EXPECT().DeletePassword(user.Id)
+
+	err := core.DisablePassword(user.Id, passwordRaw)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected DisablePassword to succeed. Error: %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+)
+
+/**
+ * Creates security codes for changing phone number of the user with given id. One security code is sent to the
+ * new phone number and another one is sent to the current phone number to confirm the change. Both codes are
+ * bound to the user. If the new phone number belongs to a user UserAlreadyExists error will be returned.
+ * Ip is the address of the caller and is used for rate limiting. Empty ip disables ip rate limit.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ * 3-UserAlreadyExists
+ * 4-SecurityCodeDeliveryFailed
+ * 5-TooManyRequests
+ * 6-PhoneNotValid
+ */
+func (s Service) RequestChangePhoneSecurityCode(userId string, phone string, ip string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	user, err := s.repository.GetUserById(userId)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	err = s.checkSecurityCodeRequestLimits(phone, ip)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.checkSecurityCodeRequestLimits(user.Phone, "")
+	switch err != nil {
+	case true:
+		return err
+	}
+	doesExists, err := s.repository.DoesUserExists(phone)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	switch doesExists {
+	case true:
+		return UserAlreadyExists{}
+	}
+	err = s.requestUserSecurityCode(user.Id, phone, security_code_change_phone_action)
+	switch err != nil {
+	case true:
+		return err
+	}
+	return s.requestUserSecurityCode(user.Id, user.Phone, security_code_confirm_change_phone_action)
+}
+
+/**
+ * Moves account of the user with given id to the new phone number if both the security code sent to the new phone
+ * number and the security code sent to the current phone number are correct and issued for the user.
+ * Both codes are verified before any of them is consumed. New phone number is claimed atomically by repository
+ * so if it is taken meanwhile, UserAlreadyExists error will be returned.
+ * If ChangePhoneTerminatesSessions is set, all sessions of the user are terminated afterwards.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ * 3-UserAlreadyExists
+ * 4-SecurityCodeNotValid
+ * 5-SecurityCodeActionDoesNotMatch
+ * 6-SecurityCodeAttemptsExceeded
+ * 7-PhoneNotValid
+ */
+func (s Service) ChangePhone(userId string, phone string, securityCode string, currentPhoneSecurityCode string) error {
+	switch qualifyPhone(phone) {
+	case false:
+		return PhoneNotValid{}
+	}
+	user, err := s.repository.GetUserById(userId)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	switch user.Phone == phone {
+	case true:
+		return nil
+	}
+	newPhoneCode, err := s.verifyUserSecurityCode(user.Id, phone, securityCode, security_code_change_phone_action)
+	switch err != nil {
+	case true:
+		return err
+	}
+	currentPhoneCode, err := s.verifyUserSecurityCode(user.Id, user.Phone, currentPhoneSecurityCode, security_code_confirm_change_phone_action)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.consumeVerifiedCode(s.phoneCodes(), user.Phone, currentPhoneCode)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.consumeVerifiedCode(s.phoneCodes(), phone, newPhoneCode)
+	switch err != nil {
+	case true:
+		return err
+	}
+	isChanged, err := s.repository.ChangePhone(user, phone)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	switch isChanged {
+	case false:
+		return UserAlreadyExists{}
+	}
+	switch s.configs.ChangePhoneTerminatesSessions {
+	case true:
+		sessions, err := s.repository.GetUserSessions(user.Id)
+		switch err != nil {
+		case true:
+			return errors.InternalError{}
+		}
+		return s.terminateSessions(sessions)
+	}
+	return nil
+}
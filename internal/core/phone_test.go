@@ -0,0 +1,173 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+)
+
+var newPhone = "+1111111111"
+
+func newChangePhoneSecurityCode() domain.SecurityCode {
+	changePhoneSecurityCode := securityCode
+	changePhoneSecurityCode.Phone = newPhone
+	changePhoneSecurityCode.Action = security_code_change_phone_action
+	changePhoneSecurityCode.UserId = user.Id
+	return changePhoneSecurityCode
+}
+
+func newConfirmChangePhoneSecurityCode() domain.SecurityCode {
+	confirmSecurityCode := securityCode
+	confirmSecurityCode.Phone = user.Phone
+	confirmSecurityCode.Action = security_code_confirm_change_phone_action
+	confirmSecurityCode.UserId = user.Id
+	return confirmSecurityCode
+}
+
+/**
+ * Normal test case. Sessions of the user must be terminated after changing phone number
+ */
+func TestService_ChangePhone(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	core.configs.ChangePhoneTerminatesSessions = true
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(newChangePhoneSecurityCode(), nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newConfirmChangePhoneSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(newPhone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().ChangePhone(user, newPhone).Return(true, nil)
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(newDummySessions(), nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Len(3))
+	repositoryMock.EXPECT().DeleteSessions(user.Id, gomock.Len(3))
+
+	err := core.ChangePhone(user.Id, newPhone, securityCodeRaw, securityCodeRaw)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected ChangePhone to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for a phone number that is claimed by another user meanwhile
+ */
+func TestService_ChangePhone2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(newChangePhoneSecurityCode(), nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(newConfirmChangePhoneSecurityCode(), nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(user.Phone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().ConsumeSecurityCode(newPhone, securityCode.SecurityCode).Return(true, nil)
+	repositoryMock.EXPECT().ChangePhone(user, newPhone).Return(false, nil)
+
+	err := core.ChangePhone(user.Id, newPhone, securityCodeRaw, securityCodeRaw)
+	switch errors.As(err, &UserAlreadyExists{}) {
+	case false:
+		t.Errorf("Proper error not returned from ChangePhone. Expected ChangePhone to return UserAlreadyExists error")
+	}
+}
+
+/**
+ * Test case for security codes of other actions
+ */
+func TestService_ChangePhone3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	loginSecurityCode := newChangePhoneSecurityCode()
+	loginSecurityCode.Action = security_code_login_action
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(loginSecurityCode, nil)
+
+	err := core.ChangePhone(user.Id, newPhone, securityCodeRaw, securityCodeRaw)
+	switch errors.As(err, &SecurityCodeActionDoesNotMatch{}) {
+	case false:
+		t.Errorf("Proper error not returned from ChangePhone. Expected ChangePhone to return SecurityCodeActionDoesNotMatch error")
+	}
+}
+
+/**
+ * Test case for a security code of the new phone that is issued for another user. No code must be consumed
+ */
+func TestService_ChangePhone4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	otherUserSecurityCode := newChangePhoneSecurityCode()
+	otherUserSecurityCode.UserId = "other-user"
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(otherUserSecurityCode, nil)
+
+	err := core.ChangePhone(user.Id, newPhone, securityCodeRaw, securityCodeRaw)
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from ChangePhone. Expected ChangePhone to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Test case for a missing confirmation from the current phone. Security code of the new phone must not be consumed
+ */
+func TestService_ChangePhone5(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(newChangePhoneSecurityCode(), nil)
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+
+	err := core.ChangePhone(user.Id, newPhone, securityCodeRaw, "")
+	switch errors.As(err, &SecurityCodeNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from ChangePhone. Expected ChangePhone to return SecurityCodeNotValid error")
+	}
+}
+
+/**
+ * Test case for a new phone number that belongs to a user
+ */
+func TestService_RequestChangePhoneSecurityCode(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(newPhone).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetSecurityCode(user.Phone).Return(domain.SecurityCode{}, errors2.EntityNotFound{})
+	repositoryMock.EXPECT().GetSecurityCodeRequests(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(gomock.Any(), gomock.Any(), gomock.Any()).Times(3)
+	repositoryMock.EXPECT().DoesUserExists(newPhone).Return(true, nil)
+
+	err := core.RequestChangePhoneSecurityCode(user.Id, newPhone, dummyIp)
+	switch errors.As(err, &UserAlreadyExists{}) {
+	case false:
+		t.Errorf("Proper error not returned from RequestChangePhoneSecurityCode. Expected RequestChangePhoneSecurityCode to return UserAlreadyExists error")
+	}
+}
+
+/**
+ * Normal test case. Security codes must be sent to both the new and the current phone and be bound to the user
+ */
+func TestService_RequestChangePhoneSecurityCode2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().GetSecurityCode(gomock.Any()).Return(domain.SecurityCode{}, errors2.EntityNotFound{}).Times(2)
+	repositoryMock.EXPECT().GetSecurityCodeRequests(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	repositoryMock.EXPECT().RecordSecurityCodeRequest(gomock.Any(), gomock.Any(), gomock.Any()).Times(3)
+	repositoryMock.EXPECT().DoesUserExists(newPhone).Return(false, nil)
+	actions := map[string]string{newPhone: security_code_change_phone_action, user.Phone: security_code_confirm_change_phone_action}
+	repositoryMock.EXPECT().RecordSecurityCode(gomock.Any()).DoAndReturn(func(recorded domain.SecurityCode) error {
+		switch recorded.UserId != user.Id || actions[recorded.Phone] != recorded.Action {
+		case true:
+			t.Errorf("Expected security code to be bound to the user and the action of the phone. Recorded security code: %+v", recorded)
+		}
+		return nil
+	}).Times(2)
+	codeSenderMock.EXPECT().SendCode(newPhone, gomock.Any(), security_code_change_phone_action)
+	codeSenderMock.EXPECT().SendCode(user.Phone, gomock.Any(), security_code_confirm_change_phone_action)
+
+	err := core.RequestChangePhoneSecurityCode(user.Id, newPhone, dummyIp)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected RequestChangePhoneSecurityCode to succeed but error returned. Error message: %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"sync"
+	"time"
+)
+
+/**
+ * Upper bounds of coarse last seen statuses
+ */
+const (
+	last_seen_recently_period     = 3 * 24 * time.Hour
+	last_seen_within_week_period  = 7 * 24 * time.Hour
+	last_seen_within_month_period = 30 * 24 * time.Hour
+)
+
+/**
+ * presenceTracker keeps online users of this instance. heartbeatAt is the last heartbeat of the user and
+ * persistedAt is the last seen time that is written for the user by this instance
+ */
+type presenceTracker struct {
+	lock  *sync.Mutex
+	users map[string]presence
+}
+
+type presence struct {
+	heartbeatAt time.Time
+	persistedAt time.Time
+}
+
+func newPresenceTracker() *presenceTracker {
+	return &presenceTracker{
+		lock:  &sync.Mutex{},
+		users: map[string]presence{},
+	}
+}
+
+/**
+ * Records a heartbeat of the user. Returns false if last seen of the user must be written
+ */
+func (p *presenceTracker) heartbeat(userId string, now time.Time, persistInterval time.Duration) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	current, isset := p.users[userId]
+	switch isset && now.Sub(current.persistedAt) < persistInterval {
+	case false:
+		return false
+	}
+	current.heartbeatAt = now
+	p.users[userId] = current
+	return true
+}
+
+/**
+ * Returns true if the user was not online on this instance
+ */
+func (p *presenceTracker) set(userId string, now time.Time) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, isset := p.users[userId]
+	p.users[userId] = presence{
+		heartbeatAt: now,
+		persistedAt: now,
+	}
+	return !isset
+}
+
+func (p *presenceTracker) remove(userId string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.users, userId)
+}
+
+/**
+ * Removes and returns users whose last heartbeat is before deadline
+ */
+func (p *presenceTracker) expire(deadline time.Time) map[string]presence {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	expired := map[string]presence{}
+	for userId, current := range p.users {
+		switch current.heartbeatAt.Before(deadline) {
+		case true:
+			expired[userId] = current
+			delete(p.users, userId)
+		}
+	}
+	return expired
+}
+
+/**
+ * Marks the user as online. The user stays online while heartbeats are sent within PresenceTimeout.
+ * Subscribers are only notified if the user was not online on this instance.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ */
+func (s Service) SetOnline(userId string) error {
+	now := time.Now()
+	err := s.repository.SetOnlineStatus(userId, true, now)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	switch s.presences.set(userId, now) {
+	case true:
+		s.presenceHub.publish(domain.PresenceUpdate{
+			UserId:   userId,
+			IsOnline: true,
+		})
+	}
+	return nil
+}
+
+/**
+ * Marks the user as offline and sets its last seen to now.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ */
+func (s Service) SetOffline(userId string) error {
+	s.presences.remove(userId)
+	now := time.Now()
+	err := s.repository.SetOnlineStatus(userId, false, now)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	s.presenceHub.publish(domain.PresenceUpdate{
+		UserId:   userId,
+		LastSeen: now,
+	})
+	return nil
+}
+
+/**
+ * Keeps the user online. Heartbeats are kept in memory and last seen is only written once per half of
+ * PresenceTimeout, so users of an instance that is stopped without expiring them are reported offline by readers.
+ * Heartbeat of a user that is not online on this instance sets it online.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UserNotFound
+ */
+func (s Service) Heartbeat(userId string) error {
+	switch s.presences.heartbeat(userId, time.Now(), s.configs.PresenceTimeout/2) {
+	case true:
+		return nil
+	}
+	return s.SetOnline(userId)
+}
+
+/**
+ * Sets users that have not sent a heartbeat within PresenceTimeout offline. Last seen of every user is set to
+ * its last heartbeat. Users that are online on another instance are not set offline.
+ * It must be called periodically and failures are only reported.
+ */
+func (s Service) ExpirePresences() {
+	expired := s.presences.expire(time.Now().Add(-s.configs.PresenceTimeout))
+	for userId, current := range expired {
+		isExpired, err := s.repository.ExpireOnlineStatus(userId, current.heartbeatAt, current.persistedAt)
+		switch {
+		case err != nil:
+			s.reportError("expiring online status of user "+userId, err)
+		case isExpired:
+			s.presenceHub.publish(domain.PresenceUpdate{
+				UserId:   userId,
+				LastSeen: current.heartbeatAt,
+			})
+		}
+	}
+}
+
+/**
+ * Online status is only reported while last seen is within PresenceTimeout so that users of an instance that
+ * is stopped do not stay online. If last seen is hidden, online status and last seen time are removed
+ * and a coarse last seen status is set instead.
+ */
+func (s Service) presentUser(user domain.User, isLastSeenHidden bool) domain.User {
+	since := time.Since(user.Last_seen)
+	user.Online_status = user.Online_status && since < s.configs.PresenceTimeout
+	user.Last_seen_status = domain.LastSeenExact
+	switch isLastSeenHidden {
+	case false:
+		return user
+	}
+	switch {
+	case user.Last_seen.IsZero():
+		user.Last_seen_status = domain.LastSeenLongAgo
+	case user.Online_status || since < last_seen_recently_period:
+		user.Last_seen_status = domain.LastSeenRecently
+	case since < last_seen_within_week_period:
+		user.Last_seen_status = domain.LastSeenWithinWeek
+	case since < last_seen_within_month_period:
+		user.Last_seen_status = domain.LastSeenWithinMonth
+	default:
+		user.Last_seen_status = domain.LastSeenLongAgo
+	}
+	user.Online_status = false
+	user.Last_seen = time.Time{}
+	return user
+}
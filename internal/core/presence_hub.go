@@ -0,0 +1,188 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"sync"
+)
+
+/**
+ * presenceHub delivers presence updates to subscriptions of this instance. Only updates that are published
+ * by this instance are delivered, so subscribers of other instances are not notified.
+ */
+type presenceHub struct {
+	lock *sync.RWMutex
+	// Subscriptions of every user id
+	subscriptions map[string]map[*PresenceSubscription]bool
+}
+
+/**
+ * PresenceSubscription receives presence updates of a set of users. Publishers never block on a subscription.
+ * Pending updates are coalesced per user so a slow subscriber only receives the latest update of every user
+ * and memory of a subscription is bounded by the number of its users.
+ */
+type PresenceSubscription struct {
+	userIds []string
+	// Users whose last seen is hidden from the subscriber. Their updates are not delivered
+	hidden  map[string]bool
+	lock    *sync.Mutex
+	pending map[string]domain.PresenceUpdate
+	// Users with pending updates in order of their first pending update
+	order  []string
+	notify chan struct{}
+}
+
+func newPresenceHub() *presenceHub {
+	return &presenceHub{
+		lock:          &sync.RWMutex{},
+		subscriptions: map[string]map[*PresenceSubscription]bool{},
+	}
+}
+
+func (p *presenceHub) subscribe(userIds []string, hidden map[string]bool) *PresenceSubscription {
+	subscription := &PresenceSubscription{
+		userIds: userIds,
+		hidden:  hidden,
+		lock:    &sync.Mutex{},
+		pending: map[string]domain.PresenceUpdate{},
+		notify:  make(chan struct{}, 1),
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, userId := range userIds {
+		switch p.subscriptions[userId] == nil {
+		case true:
+			p.subscriptions[userId] = map[*PresenceSubscription]bool{}
+		}
+		p.subscriptions[userId][subscription] = true
+	}
+	return subscription
+}
+
+func (p *presenceHub) unsubscribe(subscription *PresenceSubscription) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, userId := range subscription.userIds {
+		delete(p.subscriptions[userId], subscription)
+		switch len(p.subscriptions[userId]) == 0 {
+		case true:
+			delete(p.subscriptions, userId)
+		}
+	}
+}
+
+func (p *presenceHub) publish(update domain.PresenceUpdate) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for subscription := range p.subscriptions[update.UserId] {
+		switch subscription.hidden[update.UserId] {
+		case true:
+			continue
+		}
+		subscription.push(update, true)
+	}
+}
+
+/**
+ * Queues the update of the user. If the user already has a pending update, it is only replaced if isNewer is set
+ */
+func (p *PresenceSubscription) push(update domain.PresenceUpdate, isNewer bool) {
+	p.lock.Lock()
+	_, isPending := p.pending[update.UserId]
+	switch {
+	case !isPending:
+		p.order = append(p.order, update.UserId)
+		p.pending[update.UserId] = update
+	case isNewer:
+		p.pending[update.UserId] = update
+	}
+	p.lock.Unlock()
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+/**
+ * Receives a value whenever there are pending updates that can be taken by Updates
+ */
+func (p *PresenceSubscription) Notify() <-chan struct{} {
+	return p.notify
+}
+
+/**
+ * Takes pending updates in the order that users were updated
+ */
+func (p *PresenceSubscription) Updates() []domain.PresenceUpdate {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	updates := make([]domain.PresenceUpdate, 0, len(p.order))
+	for _, userId := range p.order {
+		updates = append(updates, p.pending[userId])
+	}
+	p.order = nil
+	p.pending = map[string]domain.PresenceUpdate{}
+	return updates
+}
+
+/**
+ * Subscribes to presence updates of the users. Current presence of the users that exist is delivered first.
+ * Ids are case insensitive and the subscription must be closed by UnsubscribePresence.
+ * Users that hide their last seen from the viewer are only delivered with a coarse current presence.
+ * Privacy rules are read once so changes of them apply to subscriptions that are made afterwards.
+ * Returned errors:
+ * 1-InternalError
+ * 2-UsersBatchTooLarge
+ */
+func (s Service) SubscribePresence(viewer Viewer, userIds []string) (*PresenceSubscription, error) {
+	unique := uniqueIds(userIds)
+	switch len(unique) > s.configs.UsersBatchMaxSize {
+	case true:
+		return nil, UsersBatchTooLarge{}
+	}
+	view, err := s.newPrivacyView(viewer, unique)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	hidden := make(map[string]bool, len(unique))
+	for _, userId := range unique {
+		hidden[userId] = !view.isVisible(userId, privacy_rule_last_seen)
+	}
+	/**
+	 * Current presences are fetched after subscribing so that no update is lost in between.
+	 * Updates that are published in the meantime are newer than fetched presences.
+	 */
+	subscription := s.presenceHub.subscribe(unique, hidden)
+	users, err := s.repository.GetUsersByIds(unique)
+	switch err != nil {
+	case true:
+		s.presenceHub.unsubscribe(subscription)
+		return nil, errors.InternalError{}
+	}
+	for _, userId := range unique {
+		user, isFound := users[userId]
+		switch isFound {
+		case true:
+			subscription.push(newPresenceUpdate(s.viewUser(view, user)), false)
+		}
+	}
+	return subscription, nil
+}
+
+func (s Service) UnsubscribePresence(subscription *PresenceSubscription) {
+	s.presenceHub.unsubscribe(subscription)
+}
+
+func newPresenceUpdate(user domain.User) domain.PresenceUpdate {
+	update := domain.PresenceUpdate{
+		UserId:         user.Id,
+		IsOnline:       user.Online_status,
+		LastSeenStatus: user.Last_seen_status,
+	}
+	switch user.Online_status {
+	case false:
+		update.LastSeen = user.Last_seen
+	}
+	return update
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+/**
+ * Test case for heartbeats of an online user. Last seen must not be written for heartbeats within presence timeout
+ */
+func TestService_Heartbeat(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().SetOnlineStatus(user.Id, true, gomock.Any()).Return(nil).Times(1)
+
+	err := core.SetOnline(user.Id)
+	switch err != nil {
+	case true:
+		t.Fatalf("Expected SetOnline to succeed but error returned. Error: %v", err)
+	}
+	err = core.Heartbeat(user.Id)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected Heartbeat to succeed but error returned. Error: %v", err)
+	}
+}
+
+/**
+ * Test case for heartbeat of a user that does not exist
+ */
+func TestService_Heartbeat2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().SetOnlineStatus(user.Id, true, gomock.Any()).Return(errors2.EntityNotFound{})
+
+	err := core.Heartbeat(user.Id)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from Heartbeat. Expected Heartbeat to return UserNotFound error")
+	}
+}
+
+/**
+ * Test case for expiry of online users. Last seen of expired users must be set to their last heartbeat
+ */
+func TestService_ExpirePresences(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	heartbeatAt := time.Now().Add(-2 * dummyConfigs.PresenceTimeout)
+	persistedAt := heartbeatAt.Add(-time.Minute)
+	core.presences.users[user.Id] = presence{
+		heartbeatAt: heartbeatAt,
+		persistedAt: persistedAt,
+	}
+	core.presences.users[searchedUser.Id] = presence{
+		heartbeatAt: time.Now(),
+		persistedAt: time.Now(),
+	}
+	repositoryMock.EXPECT().ExpireOnlineStatus(user.Id, heartbeatAt, persistedAt).Return(true, nil)
+
+	core.ExpirePresences()
+	_, isset := core.presences.users[searchedUser.Id]
+	switch isset {
+	case false:
+		t.Errorf("Expected ExpirePresences to keep users with a recent heartbeat online")
+	}
+}
+
+/**
+ * Test case for users with hidden last seen. Exact last seen and online status must not be exposed
+ */
+func TestService_presentUser(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	hidden := user
+	hidden.Online_status = true
+	hidden.Last_seen = time.Now().Add(-5 * 24 * time.Hour)
+
+	presented := core.presentUser(hidden, true)
+	switch presented.Online_status || !presented.Last_seen.IsZero() || presented.Last_seen_status != domain.LastSeenWithinWeek {
+	case true:
+		t.Errorf("Expected presentUser to only expose a coarse last seen status. Presented user: %v", presented)
+	}
+}
+
+/**
+ * Test case for coalescing updates of a subscriber. Current presence must be replaced by the newer update of the user
+ */
+func TestService_SubscribePresence(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	offline := user
+	offline.Last_seen = time.Now().Add(-time.Hour)
+	repositoryMock.EXPECT().GetUsersByIds([]string{user.Id}).Return(map[string]domain.User{user.Id: offline}, nil)
+	repositoryMock.EXPECT().SetOnlineStatus(user.Id, true, gomock.Any()).Return(nil)
+
+	subscription, err := core.SubscribePresence(selfViewer, []string{user.Id, user.Id})
+	switch err != nil {
+	case true:
+		t.Fatalf("Expected SubscribePresence to succeed but error returned. Error: %v", err)
+	}
+	defer core.UnsubscribePresence(subscription)
+	core.SetOnline(user.Id)
+	<-subscription.Notify()
+	updates := subscription.Updates()
+	switch len(updates) != 1 || !updates[0].IsOnline || !updates[0].LastSeen.IsZero() {
+	case true:
+		t.Errorf("Expected subscription to only receive the latest update of the user. Received updates: %v", updates)
+	}
+}
+
+/**
+ * Test case for closed subscriptions. Updates must not be delivered after unsubscribing
+ */
+func TestService_SubscribePresence2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUsersByIds([]string{user.Id}).Return(map[string]domain.User{}, nil)
+	repositoryMock.EXPECT().SetOnlineStatus(user.Id, false, gomock.Any()).Return(nil)
+
+	subscription, err := core.SubscribePresence(selfViewer, []string{user.Id})
+	switch err != nil {
+	case true:
+		t.Fatalf("Expected SubscribePresence to succeed but error returned. Error: %v", err)
+	}
+	core.UnsubscribePresence(subscription)
+	core.SetOffline(user.Id)
+	select {
+	case <-subscription.Notify():
+		t.Errorf("Expected unsubscribed subscription not to receive updates. Received updates: %v", subscription.Updates())
+	default:
+	}
+}
+
+/**
+ * Test case for subscribing to more users than the maximum batch size
+ */
+func TestService_SubscribePresence3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	_, err := core.SubscribePresence(selfViewer, []string{"1", "2", "3", "4"})
+	switch errors.As(err, &UsersBatchTooLarge{}) {
+	case false:
+		t.Errorf("Proper error not returned from SubscribePresence. Expected SubscribePresence to return UsersBatchTooLarge error")
+	}
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+)
+
+const (
+	privacy_rule_phone     = "PHONE"
+	privacy_rule_last_seen = "LAST_SEEN"
+	// Profile photos are not stored by this service. The rule is kept for clients and services that serve photos
+	privacy_rule_profile_photo = "PROFILE_PHOTO"
+	privacy_rule_bio           = "BIO"
+	// Finding the user by GetUserByPhone
+	privacy_rule_find_by_phone = "FIND_BY_PHONE"
+)
+
+const (
+	privacy_visibility_everybody = "EVERYBODY"
+	// Users that the owner of the rule has in its contacts
+	privacy_visibility_contacts = "CONTACTS"
+	privacy_visibility_nobody   = "NOBODY"
+)
+
+/**
+ * Maximum number of users of every exception list of a privacy rule
+ */
+const privacy_exceptions_max_size = 1000
+
+/**
+ * Privacy rules in the order that they are returned to users
+ */
+var privacyRules = []string{
+	privacy_rule_phone,
+	privacy_rule_last_seen,
+	privacy_rule_profile_photo,
+	privacy_rule_bio,
+	privacy_rule_find_by_phone,
+}
+
+/**
+ * Visibility of the rules that users have not set
+ */
+var defaultPrivacyVisibilities = map[string]string{
+	privacy_rule_phone:         privacy_visibility_contacts,
+	privacy_rule_last_seen:     privacy_visibility_everybody,
+	privacy_rule_profile_photo: privacy_visibility_everybody,
+	privacy_rule_bio:           privacy_visibility_everybody,
+	privacy_rule_find_by_phone: privacy_visibility_everybody,
+}
+
+/**
+ * Viewer is the caller of a read method. UserId is empty for anonymous callers.
+ * Privacy rules are not applied for admins and for users that view themselves.
+ */
+type Viewer struct {
+	UserId  string
+	IsAdmin bool
+}
+
+/**
+ * privacyView decides what the viewer can see of a set of users. Rules are keyed by user id and rule name.
+ * contactOwners are the users that have the viewer in their contacts.
+ */
+type privacyView struct {
+	viewer        Viewer
+	rules         map[string]map[string]domain.PrivacyRule
+	contactOwners map[string]bool
+}
+
+/**
+ * Sets a privacy rule of the user. Ids of the exception lists are case insensitive and each list can contain at most
+ * privacy_exceptions_max_size users. A user can not be in both lists.
+ * Returned errors:
+ * 1-InternalError
+ * 2-PrivacyRuleNotValid
+ */
+func (s Service) SetPrivacyRule(userId string, rule domain.PrivacyRule) error {
+	_, isset := defaultPrivacyVisibilities[rule.Rule]
+	switch isset && isPrivacyVisibilityValid(rule.Visibility) {
+	case false:
+		return PrivacyRuleNotValid{}
+	}
+	rule.UserId = userId
+	rule.AllowUsers = uniqueIds(rule.AllowUsers)
+	rule.DenyUsers = uniqueIds(rule.DenyUsers)
+	switch len(rule.AllowUsers) > privacy_exceptions_max_size || len(rule.DenyUsers) > privacy_exceptions_max_size {
+	case true:
+		return PrivacyRuleNotValid{}
+	}
+	for _, id := range rule.AllowUsers {
+		switch containsId(rule.DenyUsers, id) {
+		case true:
+			return PrivacyRuleNotValid{}
+		}
+	}
+	err := s.repository.SetPrivacyRule(rule)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns all privacy rules of the user. Rules that the user has not set are returned with their default visibility.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) GetPrivacyRules(userId string) ([]domain.PrivacyRule, error) {
+	stored, err := s.repository.GetPrivacyRules([]string{userId})
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	view := privacyView{
+		rules: groupPrivacyRules(stored),
+	}
+	rules := make([]domain.PrivacyRule, 0, len(privacyRules))
+	for _, name := range privacyRules {
+		rules = append(rules, view.rule(userId, name))
+	}
+	return rules, nil
+}
+
+/**
+ * Fetches privacy rules of the users for the viewer. Rules are not fetched for admins and the viewer itself.
+ * Contacts of the users are only looked up if the viewer is a user and a rule depends on contacts.
+ */
+func (s Service) newPrivacyView(viewer Viewer, userIds []string) (privacyView, error) {
+	view := privacyView{
+		viewer:        viewer,
+		rules:         map[string]map[string]domain.PrivacyRule{},
+		contactOwners: map[string]bool{},
+	}
+	switch viewer.IsAdmin {
+	case true:
+		return view, nil
+	}
+	others := make([]string, 0, len(userIds))
+	for _, id := range uniqueIds(userIds) {
+		switch id == viewer.UserId {
+		case false:
+			others = append(others, id)
+		}
+	}
+	switch len(others) == 0 {
+	case true:
+		return view, nil
+	}
+	rules, err := s.repository.GetPrivacyRules(others)
+	switch err != nil {
+	case true:
+		return privacyView{}, errors.InternalError{}
+	}
+	view.rules = groupPrivacyRules(rules)
+	switch viewer.UserId == "" {
+	case true:
+		return view, nil
+	}
+	owners := make([]string, 0, len(others))
+	for _, id := range others {
+		switch view.dependsOnContacts(id) {
+		case true:
+			owners = append(owners, id)
+		}
+	}
+	switch len(owners) == 0 {
+	case true:
+		return view, nil
+	}
+	view.contactOwners, err = s.contactOwners(viewer.UserId, owners)
+	switch err != nil {
+	case true:
+		return privacyView{}, errors.InternalError{}
+	}
+	return view, nil
+}
+
+/**
+ * Returns the users among owners that have the viewer in their contacts
+ */
+func (s Service) contactOwners(viewerId string, owners []string) (map[string]bool, error) {
+	return s.repository.GetContactOwners(viewerId, owners)
+}
+
+/**
+ * Removes phone and bio of the user if the viewer can not see them. Exact last seen is replaced by a coarse
+ * status if it is hidden from the viewer.
+ */
+func (s Service) viewUser(view privacyView, user domain.User) domain.User {
+	switch view.isVisible(user.Id, privacy_rule_phone) {
+	case false:
+		user.Phone = ""
+	}
+	switch view.isVisible(user.Id, privacy_rule_bio) {
+	case false:
+		user.Bio = ""
+	}
+	return s.presentUser(user, !view.isVisible(user.Id, privacy_rule_last_seen))
+}
+
+func (p privacyView) isVisible(userId string, name string) bool {
+	switch p.viewer.IsAdmin || (p.viewer.UserId != "" && p.viewer.UserId == userId) {
+	case true:
+		return true
+	}
+	rule := p.rule(userId, name)
+	switch {
+	case p.viewer.UserId == "":
+		return rule.Visibility == privacy_visibility_everybody
+	case containsId(rule.DenyUsers, p.viewer.UserId):
+		return false
+	case containsId(rule.AllowUsers, p.viewer.UserId):
+		return true
+	}
+	switch rule.Visibility {
+	case privacy_visibility_everybody:
+		return true
+	case privacy_visibility_contacts:
+		return p.contactOwners[userId]
+	default:
+		return false
+	}
+}
+
+func (p privacyView) dependsOnContacts(userId string) bool {
+	for _, name := range privacyRules {
+		switch p.rule(userId, name).Visibility == privacy_visibility_contacts {
+		case true:
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Returns the rule of the user or the default rule if the user has not set it
+ */
+func (p privacyView) rule(userId string, name string) domain.PrivacyRule {
+	rule, isset := p.rules[userId][name]
+	switch isset {
+	case true:
+		return rule
+	}
+	return domain.PrivacyRule{
+		UserId:     userId,
+		Rule:       name,
+		Visibility: defaultPrivacyVisibilities[name],
+		AllowUsers: []string{},
+		DenyUsers:  []string{},
+	}
+}
+
+func groupPrivacyRules(rules []domain.PrivacyRule) map[string]map[string]domain.PrivacyRule {
+	grouped := map[string]map[string]domain.PrivacyRule{}
+	for _, rule := range rules {
+		switch grouped[rule.UserId] == nil {
+		case true:
+			grouped[rule.UserId] = map[string]domain.PrivacyRule{}
+		}
+		grouped[rule.UserId][rule.Rule] = rule
+	}
+	return grouped
+}
+
+func isPrivacyVisibilityValid(visibility string) bool {
+	switch visibility {
+	case privacy_visibility_everybody, privacy_visibility_contacts, privacy_visibility_nobody:
+		return true
+	}
+	return false
+}
+
+func containsId(ids []string, id string) bool {
+	for _, current := range ids {
+		switch current == id {
+		case true:
+			return true
+		}
+	}
+	return false
+}
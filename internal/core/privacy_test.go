@@ -0,0 +1,110 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+	"time"
+)
+
+var selfViewer = Viewer{
+	UserId: user.Id,
+}
+
+var strangerViewer = Viewer{
+	UserId: "22222222-2222-2222-2222-222222222222",
+}
+
+/**
+ * Test case for rules with a user in both exception lists
+ */
+func TestService_SetPrivacyRule(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	err := core.SetPrivacyRule(user.Id, domain.PrivacyRule{
+		Rule:       privacy_rule_bio,
+		Visibility: privacy_visibility_nobody,
+		AllowUsers: []string{strangerViewer.UserId},
+		DenyUsers:  []string{strangerViewer.UserId},
+	})
+	switch errors.As(err, &PrivacyRuleNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from SetPrivacyRule. Expected SetPrivacyRule to return PrivacyRuleNotValid error")
+	}
+}
+
+/**
+ * Test case for rules that the user has not set. Default visibility must be returned
+ */
+func TestService_GetPrivacyRules(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetPrivacyRules([]string{user.Id}).Return([]domain.PrivacyRule{
+		{UserId: user.Id, Rule: privacy_rule_bio, Visibility: privacy_visibility_nobody},
+	}, nil)
+
+	rules, err := core.GetPrivacyRules(user.Id)
+	switch err != nil || len(rules) != len(privacyRules) || rules[0].Visibility != privacy_visibility_contacts || rules[3].Visibility != privacy_visibility_nobody {
+	case true:
+		t.Errorf("Expected GetPrivacyRules to return set rules and defaults of other rules. Returned rules: %v, error: %v", rules, err)
+	}
+}
+
+/**
+ * Test case for a stranger viewing a user. Hidden fields must be removed and last seen must be coarse
+ */
+func TestService_GetUserById_privacy(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	private := user
+	private.Bio = "bio"
+	private.Last_seen = time.Now().Add(-time.Hour)
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(private, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{user.Id}).Return([]domain.PrivacyRule{
+		{UserId: user.Id, Rule: privacy_rule_last_seen, Visibility: privacy_visibility_nobody},
+		{UserId: user.Id, Rule: privacy_rule_bio, Visibility: privacy_visibility_nobody, AllowUsers: []string{strangerViewer.UserId}},
+	}, nil)
+	repositoryMock.EXPECT().GetContactOwners(strangerViewer.UserId, []string{user.Id}).Return(map[string]bool{}, nil)
+
+	viewed, err := core.GetUserById(strangerViewer, user.Id)
+	switch err != nil || viewed.Phone != "" || viewed.Bio != private.Bio || !viewed.Last_seen.IsZero() || viewed.Last_seen_status != domain.LastSeenRecently {
+	case true:
+		t.Errorf("Expected GetUserById to apply privacy rules of the user. Returned user: %v, error: %v", viewed, err)
+	}
+}
+
+/**
+ * Test case for users that can not be found by phone. UserNotFound must be returned
+ */
+func TestService_GetUserByPhone_privacy(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserByPhone(user.Phone).Return(user, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{user.Id}).Return([]domain.PrivacyRule{
+		{UserId: user.Id, Rule: privacy_rule_find_by_phone, Visibility: privacy_visibility_nobody},
+	}, nil)
+	repositoryMock.EXPECT().GetContactOwners(strangerViewer.UserId, []string{user.Id}).Return(map[string]bool{}, nil)
+
+	_, err := core.GetUserByPhone(strangerViewer, user.Phone)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from GetUserByPhone. Expected GetUserByPhone to return UserNotFound error")
+	}
+}
+
+/**
+ * Test case for anonymous viewers. Phone numbers must not be enumerable without an account
+ */
+func TestService_GetUserByPhone_anonymous(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserByPhone(gomock.Any()).Times(0)
+
+	_, err := core.GetUserByPhone(Viewer{}, user.Phone)
+	switch errors.As(err, &UserNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from GetUserByPhone. Expected GetUserByPhone to return UserNotFound error")
+	}
+}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/**
+ * Names of the profile fields that can be used in field masks
+ */
+const (
+	profile_field_name     = "name"
+	profile_field_lastname = "lastname"
+	profile_field_bio      = "bio"
+)
+
+/**
+ * Max length of profile fields in characters
+ */
+const (
+	name_max_length     = 64
+	lastname_max_length = 64
+	bio_max_length      = 70
+)
+
+var profileFields = []string{
+	profile_field_name,
+	profile_field_lastname,
+	profile_field_bio,
+}
+
+/**
+ * Updates the fields of the profile of the user with given id. Fields are case insensitive names of profile
+ * fields (name, lastname and bio). Empty fields updates all profile fields. Only name, lastname and bio
+ * of the profile are used.
+ * Profile rules:
+ * name is required and can have at most 64 characters
+ * lastname can have at most 64 characters
+ * name and lastname must only contain letters, digits, spaces and ' - . characters
+ * bio can have at most 70 characters and must not contain control characters like new line
+ * Returned errors:
+ * 1-InternalError
+ * 2-ProfileNotValid
+ * 3-UserNotFound
+ */
+func (s Service) UpdateProfile(userId string, profile domain.User, fields []string) error {
+	fields, err := normalizeProfileFields(fields)
+	switch err != nil {
+	case true:
+		return err
+	}
+	profile = trimProfile(profile)
+	err = validateProfile(profile, fields)
+	switch err != nil {
+	case true:
+		return err
+	}
+	user, err := s.repository.GetUserById(userId)
+	switch err != nil {
+	case true:
+		return userLookupError(err)
+	}
+	for _, field := range fields {
+		switch field {
+		case profile_field_name:
+			user.Name = profile.Name
+		case profile_field_lastname:
+			user.Lastname = profile.Lastname
+		case profile_field_bio:
+			user.Bio = profile.Bio
+		}
+	}
+	err = s.repository.UpdateProfile(user, fields)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	s.indexUser(user.Id, searchTokens(user))
+	return nil
+}
+
+/**
+ * Lowercases fields and removes duplicates. Unknown fields are rejected with ProfileNotValid error
+ */
+func normalizeProfileFields(fields []string) ([]string, error) {
+	switch len(fields) == 0 {
+	case true:
+		return profileFields, nil
+	}
+	normalized := make([]string, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		field = strings.ToLower(field)
+		switch field {
+		case profile_field_name, profile_field_lastname, profile_field_bio:
+		default:
+			return nil, ProfileNotValid{Field: field}
+		}
+		switch seen[field] {
+		case false:
+			seen[field] = true
+			normalized = append(normalized, field)
+		}
+	}
+	return normalized, nil
+}
+
+func trimProfile(profile domain.User) domain.User {
+	profile.Name = strings.TrimSpace(profile.Name)
+	profile.Lastname = strings.TrimSpace(profile.Lastname)
+	profile.Bio = strings.TrimSpace(profile.Bio)
+	return profile
+}
+
+func validateProfile(profile domain.User, fields []string) error {
+	for _, field := range fields {
+		isValid := true
+		switch field {
+		case profile_field_name:
+			isValid = profile.Name != "" && qualifyName(profile.Name, name_max_length)
+		case profile_field_lastname:
+			isValid = qualifyName(profile.Lastname, lastname_max_length)
+		case profile_field_bio:
+			isValid = qualifyBio(profile.Bio)
+		}
+		switch isValid {
+		case false:
+			return ProfileNotValid{Field: field}
+		}
+	}
+	return nil
+}
+
+func qualifyName(name string, maxLength int) bool {
+	switch utf8.ValidString(name) && utf8.RuneCountInString(name) <= maxLength {
+	case false:
+		return false
+	}
+	for _, character := range name {
+		switch unicode.IsLetter(character) || unicode.IsMark(character) || unicode.IsDigit(character) || strings.ContainsRune(" '-.", character) {
+		case false:
+			return false
+		}
+	}
+	return true
+}
+
+func qualifyBio(bio string) bool {
+	switch utf8.ValidString(bio) && utf8.RuneCountInString(bio) <= bio_max_length {
+	case false:
+		return false
+	}
+	for _, character := range bio {
+		switch unicode.IsPrint(character) || character == ' ' {
+		case false:
+			return false
+		}
+	}
+	return true
+}
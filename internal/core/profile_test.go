@@ -0,0 +1,124 @@
+package core
+
+import (
+	"errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"strings"
+	"testing"
+)
+
+type validateProfile_parameter struct {
+	profile  domain.User
+	field    string
+	expected bool
+}
+
+/**
+ * Normal test case. Only the fields of the mask must be updated
+ */
+func TestService_UpdateProfile(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	updated := user
+	updated.Bio = "Hello there"
+	repositoryMock.EXPECT().GetUserById(user.Id).Return(user, nil)
+	repositoryMock.EXPECT().UpdateProfile(updated, []string{profile_field_bio})
+
+	err := core.UpdateProfile(user.Id, domain.User{Name: "ignored", Bio: " Hello there "}, []string{"Bio", "bio"})
+	switch err != nil {
+	case true:
+		t.Errorf("Expected UpdateProfile to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for unknown fields
+ */
+func TestService_UpdateProfile2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	err := core.UpdateProfile(user.Id, user, []string{"phone"})
+	profileNotValid := ProfileNotValid{}
+	switch errors.As(err, &profileNotValid) && profileNotValid.Field == "phone" {
+	case false:
+		t.Errorf("Proper error not returned from UpdateProfile. Expected UpdateProfile to return ProfileNotValid error for phone field")
+	}
+}
+
+/**
+ * Test case for empty mask. All fields must be validated and updated
+ */
+func TestService_UpdateProfile3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	err := core.UpdateProfile(user.Id, domain.User{Lastname: user.Lastname}, nil)
+	profileNotValid := ProfileNotValid{}
+	switch errors.As(err, &profileNotValid) && profileNotValid.Field == profile_field_name {
+	case false:
+		t.Errorf("Proper error not returned from UpdateProfile. Expected UpdateProfile to return ProfileNotValid error for empty name")
+	}
+}
+
+func Test_validateProfile(t *testing.T) {
+	parameters := []validateProfile_parameter{
+		{
+			profile:  domain.User{Name: "Arshiya"},
+			field:    profile_field_name,
+			expected: true,
+		},
+		{
+			// non latin letters
+			profile:  domain.User{Name: "آرشیا"},
+			field:    profile_field_name,
+			expected: true,
+		},
+		{
+			profile:  domain.User{Name: ""},
+			field:    profile_field_name,
+			expected: false,
+		},
+		{
+			// containing invalid character ( @ )
+			profile:  domain.User{Name: "Arshiya@"},
+			field:    profile_field_name,
+			expected: false,
+		},
+		{
+			profile:  domain.User{Lastname: ""},
+			field:    profile_field_lastname,
+			expected: true,
+		},
+		{
+			// too long
+			profile:  domain.User{Lastname: strings.Repeat("a", lastname_max_length+1)},
+			field:    profile_field_lastname,
+			expected: false,
+		},
+		{
+			profile:  domain.User{Bio: strings.Repeat("ب", bio_max_length)},
+			field:    profile_field_bio,
+			expected: true,
+		},
+		{
+			// too long
+			profile:  domain.User{Bio: strings.Repeat("a", bio_max_length+1)},
+			field:    profile_field_bio,
+			expected: false,
+		},
+		{
+			// containing new line
+			profile:  domain.User{Bio: "first\nsecond"},
+			field:    profile_field_bio,
+			expected: false,
+		},
+	}
+	for _, parameter := range parameters {
+		result := validateProfile(parameter.profile, []string{parameter.field}) == nil
+		switch result != parameter.expected {
+		case true:
+			t.Errorf("Expected validateProfile to return %v for %s of profile %+v", parameter.expected, parameter.field, parameter.profile)
+		}
+	}
+}
@@ -1,15 +1,89 @@
 package core
 
-import "github.com/zytell3301/tg-users-service/internal/domain"
+import (
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"time"
+)
 
 type UsersRepository interface {
-	NewUser(user domain.User) error
-	UpdateUsername(phone string, username string) error
-	DeleteUser(phone string) error
+	// Atomically claims the phone for the new user and returns id of the created user.
+	// If the phone is already claimed false is returned
+	NewUser(user domain.User) (string, bool, error)
+	// Atomically claims the username for the user. If the username is owned by another user false is returned
+	UpdateUsername(phone string, username string) (bool, error)
+	// Updates given fields (name, lastname or bio) of the profile to the values of the user
+	UpdateProfile(user domain.User, fields []string) error
+	// Atomically claims the new phone for the user and releases the old one. If the phone is already claimed false is returned
+	ChangePhone(user domain.User, phone string) (bool, error)
+	// Deletes profile, username, password, pending security code and sessions of the user
+	DeleteUser(user domain.User) error
 	DoesUserExists(phone string) (bool, error)
 	DoesUsernameExists(username string) (bool, error)
 	RecordSecurityCode(securityCode domain.SecurityCode) error
 	GetSecurityCode(phone string) (domain.SecurityCode, error)
+	IncrementSecurityCodeAttempts(phone string) (int, error)
+	DeleteSecurityCode(phone string) error
+	ConsumeSecurityCode(phone string, code string) (bool, error)
+	// Email security codes are keyed by id of the user and never share keys with phone security codes
+	RecordEmailCode(securityCode domain.SecurityCode) error
+	GetEmailCode(userId string) (domain.SecurityCode, error)
+	IncrementEmailCodeAttempts(userId string) (int, error)
+	DeleteEmailCode(userId string) error
+	ConsumeEmailCode(userId string, code string) (bool, error)
+	RecordSecurityCodeRequest(key string, requestedAt time.Time, ttl time.Duration) error
+	GetSecurityCodeRequests(key string, since time.Time) ([]time.Time, error)
+	RecordCertificate(certificate domain.Certificate) error
+	GetUserCertificates(userId string) ([]domain.Certificate, error)
+	RevokeCertificates(certificates []domain.Certificate) error
+	IsCertificateRevoked(serial string) (bool, error)
+	GetRevokedCertificates() ([]domain.Certificate, error)
+	NewSession(session domain.Session) error
+	GetUserSessions(userId string) ([]domain.Session, error)
+	DeleteSessions(userId string, serials []string) error
+	ScheduleAccountDeletion(deletion domain.AccountDeletion) error
+	GetAccountDeletion(userId string) (domain.AccountDeletion, error)
+	CancelAccountDeletion(userId string) error
+	// Returns scheduled deletions with DeleteAt before or equal to until
+	GetDueAccountDeletions(until time.Time) ([]domain.AccountDeletion, error)
+	RecordAccountActivity(userId string, at time.Time) error
+	SetAccountTTL(userId string, ttlDays int) error
+	// Returns zero if the user has not set the ttl
+	GetAccountTTL(userId string) (int, error)
+	// Returns one page of activities of all users and paging state of the next page. Nil page state is the first page
+	// and empty returned page state means that there is no more page
+	GetAccountActivities(pageState []byte) ([]domain.AccountActivity, []byte, error)
+	DeleteAccountActivity(userId string) error
+	SetPassword(password domain.Password) error
+	GetPassword(userId string) (domain.Password, error)
+	DeletePassword(userId string) error
+	// Pending login is expired after ttl
+	RecordPendingLogin(login domain.PendingLogin, ttl time.Duration) error
+	GetPendingLogin(tokenHash string) (domain.PendingLogin, error)
+	// Returns false if the pending login is already deleted. Only one of concurrent deletions returns true
+	DeletePendingLogin(tokenHash string) (bool, error)
 	GetUserByPhone(phone string) (domain.User, error)
 	GetUserByUsername(username string) (domain.User, error)
+	GetUserById(id string) (domain.User, error)
+	// Returns found users keyed by id. Users that do not exist are not included
+	GetUsersByIds(ids []string) (map[string]domain.User, error)
+	// Returns EntityNotFound error if the user does not exist
+	SetOnlineStatus(userId string, isOnline bool, lastSeen time.Time) error
+	// Sets the user offline only if its last seen is still persistedAt. Returns false if it is not set offline
+	ExpireOnlineStatus(userId string, lastSeen time.Time, persistedAt time.Time) (bool, error)
+	SetPrivacyRule(rule domain.PrivacyRule) error
+	// Returns rules of all given users. Rules that are not set by users are not included
+	GetPrivacyRules(userIds []string) ([]domain.PrivacyRule, error)
+	// Returns ids of the users of the phones keyed by phone. Phones without a user are not included
+	GetUserIdsByPhones(phones []string) (map[string]string, error)
+	// Contacts that already exist are replaced
+	AddContacts(contacts []domain.Contact) error
+	GetContacts(ownerId string) ([]domain.Contact, error)
+	DeleteContacts(ownerId string, userIds []string) error
+	// Returns the users among owners that have the user in their contacts
+	GetContactOwners(userId string, ownerIds []string) (map[string]bool, error)
+	// Returns all users that have the user in their contacts
+	GetReverseContacts(userId string) ([]string, error)
+	// Records are expired after ttl
+	RecordContactImports(ownerId string, phones []string, importedAt time.Time, ttl time.Duration) error
+	GetContactImports(ownerId string, since time.Time) (map[string]time.Time, error)
 }
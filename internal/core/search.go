@@ -0,0 +1,142 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	search_query_min_length = 2
+	// Longer prefixes are not indexed. Longer queries are matched by filtering the results of their prefix
+	search_token_max_length = 32
+	search_default_limit    = 20
+	search_max_limit        = 50
+)
+
+/**
+ * Searches users whose username or a word of their name or lastname starts with every word of the query.
+ * Search is case insensitive and a leading @ of the query is ignored. Zero limit uses the default page size.
+ * Returned page token must be passed for fetching the next page and it is empty on the last page.
+ * Pages may contain less than limit users when the query has more than one word.
+ * Privacy rules of the users are applied for the viewer.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SearchQueryNotValid
+ */
+func (s Service) SearchUsers(viewer Viewer, query string, limit int, pageToken string) ([]domain.User, string, error) {
+	words := strings.Fields(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(query), "@")))
+	token := ""
+	for _, word := range words {
+		switch utf8.RuneCountInString(word) > utf8.RuneCountInString(token) {
+		case true:
+			token = word
+		}
+	}
+	switch utf8.RuneCountInString(token) < search_query_min_length {
+	case true:
+		return nil, "", SearchQueryNotValid{}
+	}
+	switch {
+	case limit <= 0:
+		limit = search_default_limit
+	case limit > search_max_limit:
+		limit = search_max_limit
+	}
+	ids, nextPageToken, err := s.searchIndex.SearchUsers(truncateRunes(token, search_token_max_length), limit, pageToken)
+	switch err != nil {
+	case true:
+		return nil, "", errors.InternalError{}
+	}
+	found, err := s.repository.GetUsersByIds(ids)
+	switch err != nil {
+	case true:
+		return nil, "", errors.InternalError{}
+	}
+	view, err := s.newPrivacyView(viewer, ids)
+	switch err != nil {
+	case true:
+		return nil, "", err
+	}
+	users := make([]domain.User, 0, len(ids))
+	for _, id := range ids {
+		user, isFound := found[id]
+		switch isFound && matchesSearch(user, words) {
+		case true:
+			users = append(users, s.viewUser(view, user))
+		}
+	}
+	return users, nextPageToken, nil
+}
+
+/**
+ * Search index is secondary data so failures of indexing are only reported
+ */
+func (s Service) indexUser(userId string, tokens []string) {
+	err := s.searchIndex.IndexUser(userId, tokens)
+	switch err != nil {
+	case true:
+		s.reportError("indexing user "+userId+" for search", err)
+	}
+}
+
+/**
+ * Returns lowercase prefixes of the username and of every word of name and lastname.
+ * Prefixes are between search_query_min_length and search_token_max_length characters.
+ */
+func searchTokens(user domain.User) []string {
+	isAdded := map[string]bool{}
+	tokens := make([]string, 0)
+	for _, word := range searchWords(user) {
+		runes := []rune(word)
+		for i := search_query_min_length; i <= len(runes) && i <= search_token_max_length; i++ {
+			token := string(runes[:i])
+			switch isAdded[token] {
+			case true:
+				continue
+			}
+			isAdded[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Strings(tokens)
+	return tokens
+}
+
+func searchWords(user domain.User) []string {
+	words := strings.Fields(strings.ToLower(user.Name + " " + user.Lastname))
+	switch user.Username != "" {
+	case true:
+		words = append(words, strings.ToLower(user.Username))
+	}
+	return words
+}
+
+/**
+ * Returns true if every query word is a prefix of a search word of the user
+ */
+func matchesSearch(user domain.User, queryWords []string) bool {
+	userWords := searchWords(user)
+	for _, queryWord := range queryWords {
+		isMatched := false
+		for _, userWord := range userWords {
+			isMatched = isMatched || strings.HasPrefix(userWord, queryWord)
+		}
+		switch isMatched {
+		case false:
+			return false
+		}
+	}
+	return true
+}
+
+func truncateRunes(value string, length int) string {
+	runes := []rune(value)
+	switch len(runes) > length {
+	case true:
+		return string(runes[:length])
+	}
+	return value
+}
@@ -0,0 +1,13 @@
+package core
+
+/**
+ * SearchIndex maps search tokens to ids of users. Tokens are computed by the core so every implementation
+ * indexes users in the same way.
+ */
+type SearchIndex interface {
+	// Replaces search tokens of the user. Empty tokens removes the user from the index
+	IndexUser(userId string, tokens []string) error
+	// Returns at most limit ids of users with the token in ascending order after cursor.
+	// Returned cursor must be passed for fetching the next page and it is empty on the last page
+	SearchUsers(token string, limit int, cursor string) ([]string, string, error)
+}
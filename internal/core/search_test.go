@@ -0,0 +1,99 @@
+package core
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"testing"
+)
+
+var searchedUser = domain.User{
+	Id:       "11111111-1111-1111-1111-111111111111",
+	Name:     "Arshiya",
+	Lastname: "Rahimi",
+	Username: "arshiya_r",
+	Phone:    "+1111111111",
+}
+
+func indexSearchUsers() {
+	memoryIndex.IndexUser(user.Id, searchTokens(user))
+	memoryIndex.IndexUser(searchedUser.Id, searchTokens(searchedUser))
+}
+
+/**
+ * Test case for queries without any word long enough to be searched
+ */
+func TestService_SearchUsers(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+
+	_, _, err := core.SearchUsers(selfViewer, " @a b ", 0, "")
+	switch errors.As(err, &SearchQueryNotValid{}) {
+	case false:
+		t.Errorf("Proper error not returned from SearchUsers. Expected SearchUsers to return SearchQueryNotValid error")
+	}
+}
+
+/**
+ * Test case for queries with more than one word. Users must match every word of the query
+ */
+func TestService_SearchUsers2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	indexSearchUsers()
+	repositoryMock.EXPECT().GetUsersByIds([]string{user.Id, searchedUser.Id}).Return(map[string]domain.User{
+		user.Id:         user,
+		searchedUser.Id: searchedUser,
+	}, nil)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{searchedUser.Id}).Return([]domain.PrivacyRule{}, nil)
+	repositoryMock.EXPECT().GetContactOwners(user.Id, []string{searchedUser.Id}).Return(map[string]bool{}, nil)
+
+	users, nextPageToken, err := core.SearchUsers(selfViewer, "ARSH ra", 0, "")
+	switch err != nil || nextPageToken != "" || len(users) != 1 || users[0].Id != searchedUser.Id {
+	case true:
+		t.Errorf("Expected SearchUsers to only return the matching user. Returned users: %v, page token: %s, error: %v", users, nextPageToken, err)
+	}
+}
+
+/**
+ * Test case for pagination. Every page must continue after the previous one
+ */
+func TestService_SearchUsers3(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	indexSearchUsers()
+	gomock.InOrder(
+		repositoryMock.EXPECT().GetUsersByIds([]string{user.Id}).Return(map[string]domain.User{user.Id: user}, nil),
+		repositoryMock.EXPECT().GetUsersByIds([]string{searchedUser.Id}).Return(map[string]domain.User{searchedUser.Id: searchedUser}, nil),
+	)
+	repositoryMock.EXPECT().GetPrivacyRules([]string{searchedUser.Id}).Return([]domain.PrivacyRule{}, nil)
+	repositoryMock.EXPECT().GetContactOwners(user.Id, []string{searchedUser.Id}).Return(map[string]bool{}, nil)
+
+	users, nextPageToken, err := core.SearchUsers(selfViewer, "@arsh", 1, "")
+	switch err != nil || nextPageToken == "" || len(users) != 1 || users[0].Id != user.Id {
+	case true:
+		t.Fatalf("Expected SearchUsers to return the first page. Returned users: %v, page token: %s, error: %v", users, nextPageToken, err)
+	}
+	users, nextPageToken, err = core.SearchUsers(selfViewer, "@arsh", 1, nextPageToken)
+	switch err != nil || nextPageToken != "" || len(users) != 1 || users[0].Id != searchedUser.Id {
+	case true:
+		t.Errorf("Expected SearchUsers to return the last page. Returned users: %v, page token: %s, error: %v", users, nextPageToken, err)
+	}
+}
+
+/**
+ * Test case for internal failure of the repository
+ */
+func TestService_SearchUsers4(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	indexSearchUsers()
+	repositoryMock.EXPECT().GetUsersByIds(gomock.Any()).Return(nil, dummyError)
+
+	_, _, err := core.SearchUsers(selfViewer, "kiani", 0, "")
+	switch errors.As(err, &errors2.InternalError{}) {
+	case false:
+		t.Errorf("Proper error not returned from SearchUsers. Expected SearchUsers to return InternalError")
+	}
+}
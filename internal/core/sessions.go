@@ -0,0 +1,96 @@
+package core
+
+import (
+	"github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+)
+
+/**
+ * Returns active sessions of the user.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) ListSessions(userId string) ([]domain.Session, error) {
+	sessions, err := s.repository.GetUserSessions(userId)
+	switch err != nil {
+	case true:
+		return nil, errors.InternalError{}
+	}
+	return sessions, nil
+}
+
+/**
+ * Terminates the session of the user with given serial. Sessions of other users are never terminated.
+ * Returned errors:
+ * 1-InternalError
+ * 2-SessionNotFound
+ */
+func (s Service) TerminateSession(userId string, serial string) error {
+	sessions, err := s.repository.GetUserSessions(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	for _, session := range sessions {
+		switch session.Serial == serial {
+		case true:
+			return s.terminateSessions([]domain.Session{session})
+		}
+	}
+	return SessionNotFound{}
+}
+
+/**
+ * Terminates all sessions of the user except the current one. Current serial MUST be taken from the verified
+ * client certificate of the caller.
+ * Returned errors:
+ * 1-InternalError
+ */
+func (s Service) TerminateAllOtherSessions(userId string, currentSerial string) error {
+	sessions, err := s.repository.GetUserSessions(userId)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	others := make([]domain.Session, 0, len(sessions))
+	for _, session := range sessions {
+		switch session.Serial != currentSerial {
+		case true:
+			others = append(others, session)
+		}
+	}
+	return s.terminateSessions(others)
+}
+
+/**
+ * Revokes certificates of the sessions and then deletes them.
+ * Certificates are revoked first so that a failure never leaves a hidden but usable session.
+ */
+func (s Service) terminateSessions(sessions []domain.Session) error {
+	switch len(sessions) == 0 {
+	case true:
+		return nil
+	}
+	certificates := make([]domain.Certificate, 0, len(sessions))
+	serials := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		certificates = append(certificates, domain.Certificate{
+			Serial:    session.Serial,
+			UserId:    session.UserId,
+			IssuedAt:  session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+		})
+		serials = append(serials, session.Serial)
+	}
+	err := s.revokeCertificates(certificates)
+	switch err != nil {
+	case true:
+		return err
+	}
+	err = s.repository.DeleteSessions(sessions[0].UserId, serials)
+	switch err != nil {
+	case true:
+		return errors.InternalError{}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"github.com/golang/mock/gomock"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var dummySessionSerial = "987654321"
+
+/**
+ * Creates a self signed certificate of the dummy user. Certificate verification is done by certGen mock
+ */
+func newDummyUserCert() []byte {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	serial, _ := new(big.Int).SetString(dummySessionSerial, 10)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: user.Id,
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}
+	cert, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+}
+
+func newDummySessions() []domain.Session {
+	return []domain.Session{
+		{UserId: user.Id, Serial: dummySessionSerial, ExpiresAt: time.Now().Add(time.Hour)},
+		{UserId: user.Id, Serial: "1", ExpiresAt: time.Now().Add(time.Hour)},
+		{UserId: user.Id, Serial: "2", ExpiresAt: time.Now().Add(time.Hour)},
+	}
+}
+
+/**
+ * Test case for internal failure of the repository
+ */
+func TestService_ListSessions(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(nil, dummyError)
+
+	_, err := core.ListSessions(user.Id)
+	switch errors.As(err, &errors2.InternalError{}) {
+	case false:
+		t.Errorf("Proper error not returned from ListSessions. Expected ListSessions to return InternalError")
+	}
+}
+
+/**
+ * Normal test case. Certificate of the session must be revoked before deletion of the session
+ */
+func TestService_TerminateSession(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(newDummySessions(), nil)
+	gomock.InOrder(
+		repositoryMock.EXPECT().RevokeCertificates(gomock.Len(1)),
+		repositoryMock.EXPECT().DeleteSessions(user.Id, []string{"1"}),
+	)
+
+	err := core.TerminateSession(user.Id, "1")
+	switch err != nil {
+	case true:
+		t.Errorf("Expected TerminateSession to succeed but error returned. Error message: %v", err)
+	}
+}
+
+/**
+ * Test case for a session that does not belong to the user
+ */
+func TestService_TerminateSession2(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(newDummySessions(), nil)
+
+	err := core.TerminateSession(user.Id, "3")
+	switch errors.As(err, &SessionNotFound{}) {
+	case false:
+		t.Errorf("Proper error not returned from TerminateSession. Expected TerminateSession to return SessionNotFound error")
+	}
+}
+
+/**
+ * Normal test case. Current session must not be terminated
+ */
+func TestService_TerminateAllOtherSessions(t *testing.T) {
+	refresh(t)
+	defer controller.Finish()
+	repositoryMock.EXPECT().GetUserSessions(user.Id).Return(newDummySessions(), nil)
+	repositoryMock.EXPECT().RevokeCertificates(gomock.Len(2))
+	repositoryMock.EXPECT().DeleteSessions(user.Id, []string{"1", "2"})
+
+	err := core.TerminateAllOtherSessions(user.Id, dummySessionSerial)
+	switch err != nil {
+	case true:
+		t.Errorf("Expected TerminateAllOtherSessions to succeed but error returned. Error message: %v", err)
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+/**
+ * AccountActivity is the last activity of a user and the inactivity period after which the account is deleted.
+ * Zero TTLDays means that the default inactivity period is used.
+ */
+type AccountActivity struct {
+	UserId       string
+	LastActivity time.Time
+	TTLDays      int
+}
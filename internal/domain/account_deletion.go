@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+/**
+ * AccountDeletion is a deletion request of a user that is executed after the grace period at DeleteAt
+ */
+type AccountDeletion struct {
+	UserId      string
+	ScheduledAt time.Time
+	DeleteAt    time.Time
+}
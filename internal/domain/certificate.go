@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+/**
+ * Certificate is the record of a login certificate that is issued for a user.
+ * Serial is the decimal representation of certificate serial number.
+ */
+type Certificate struct {
+	Serial    string
+	UserId    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
@@ -0,0 +1,14 @@
+package domain
+
+/**
+ * Contact is a user in the contact book of its owner. Phone, name and lastname are the ones that are imported
+ * by the owner. IsMutual indicates that the user also has the owner in its contacts.
+ */
+type Contact struct {
+	OwnerId  string
+	UserId   string
+	Phone    string
+	Name     string
+	Lastname string
+	IsMutual bool
+}
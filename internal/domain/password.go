@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+/**
+ * Password is the two-step verification password of a user. Only the hash of the password is stored.
+ * Recovery email can only be used for password recovery after it is verified.
+ */
+type Password struct {
+	UserId                string
+	Hash                  string
+	Hint                  string
+	RecoveryEmail         string
+	RecoveryEmailVerified bool
+	UpdatedAt             time.Time
+}
+
+/**
+ * PendingLogin is a login with a verified security code that waits for the password of the user.
+ * PublicKey is the DER encoded PKIX key that is certified after the password is checked.
+ */
+type PendingLogin struct {
+	TokenHash string
+	UserId    string
+	PublicKey []byte
+	Device    string
+	Ip        string
+	CreatedAt time.Time
+}
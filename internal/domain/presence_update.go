@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+/**
+ * PresenceUpdate is a change of online status of a user. LastSeen is the time that the user went offline
+ * and it is zero for online users
+ */
+type PresenceUpdate struct {
+	UserId         string
+	IsOnline       bool
+	LastSeen       time.Time
+	LastSeenStatus LastSeenStatus
+}
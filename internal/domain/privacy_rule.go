@@ -0,0 +1,13 @@
+package domain
+
+/**
+ * PrivacyRule decides who can see a piece of information of a user. Visibility applies to users that are in
+ * none of the exception lists. Users of DenyUsers can never see the information even if they are in AllowUsers.
+ */
+type PrivacyRule struct {
+	UserId     string
+	Rule       string
+	Visibility string
+	AllowUsers []string
+	DenyUsers  []string
+}
@@ -6,5 +6,8 @@ type SecurityCode struct {
 	Phone        string
 	SecurityCode string
 	Action       string
+	Attempts     int
 	CreatedAt    time.Time
+	// Id of the user that the security code is issued for. Empty if the security code is not bound to a user
+	UserId string
 }
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+/**
+ * Session is a successful login of a user. Each session is bound to the certificate issued on login.
+ * Serial is the decimal representation of certificate serial number.
+ */
+type Session struct {
+	UserId    string
+	Serial    string
+	Device    string
+	Ip        string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -12,5 +12,23 @@ type User struct {
 	Username      string
 	Phone         string
 	Online_status bool
-	Created_at    time.Time
+	// Last time that the user was online. It is zero if it is unknown or hidden
+	Last_seen        time.Time
+	Last_seen_status LastSeenStatus
+	Created_at       time.Time
 }
+
+/**
+ * LastSeenStatus indicates how last seen of a user is exposed. Coarse statuses are used instead of
+ * the exact last seen time when it is hidden
+ */
+type LastSeenStatus int
+
+const (
+	LastSeenExact LastSeenStatus = iota
+	// Within 3 days
+	LastSeenRecently
+	LastSeenWithinWeek
+	LastSeenWithinMonth
+	LastSeenLongAgo
+)
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+/**
+ * UserDeleted is published after an account is deleted so that other services can purge data of the user
+ */
+type UserDeleted struct {
+	UserId    string    `json:"user_id"`
+	Phone     string    `json:"phone"`
+	Username  string    `json:"username"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
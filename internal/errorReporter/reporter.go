@@ -23,10 +23,14 @@ func NewReporter(instanceId string, serviceId string, errorReporter ErrorReporte
 		errorReporter: errorReporter,
 	}
 	r.reportError = func(message string, parameters ...string) {
+		arguments := make([]interface{}, 0, len(parameters))
+		for _, parameter := range parameters {
+			arguments = append(arguments, parameter)
+		}
 		r.errorReporter.Report(ErrorReporter.Error{
 			ServiceGroupId:  r.serviceId,
 			InstanceId: r.instanceId,
-			Message:    fmt.Sprintf(message, parameters),
+			Message:    fmt.Sprintf(message, arguments...),
 		})
 	}
 	return r
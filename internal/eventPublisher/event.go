@@ -0,0 +1,16 @@
+package eventPublisher
+
+/**
+ * Types of published events
+ */
+const (
+	UserDeletedEvent = "USER_DELETED"
+)
+
+/**
+ * Envelope of every published event. Payload is the json encoded event itself
+ */
+type event struct {
+	Type    string      `json:"type"`
+	Payload interface{} `json:"payload"`
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../core/event_publisher.go
+
+// Package eventPublisher is a generated GoMock package.
+package eventPublisher
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/zytell3301/tg-users-service/internal/domain"
+)
+
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishUserDeleted mocks base method.
+func (m *MockEventPublisher) PublishUserDeleted(event domain.UserDeleted) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishUserDeleted", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishUserDeleted indicates an expected call of PublishUserDeleted.
+func (mr *MockEventPublisherMockRecorder) PublishUserDeleted(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishUserDeleted", reflect.TypeOf((*MockEventPublisher)(nil).PublishUserDeleted), event)
+}
@@ -0,0 +1,61 @@
+package eventPublisher
+
+import (
+	"encoding/json"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"os"
+	"sync"
+)
+
+/**
+ * FilePublisher appends every event as a json line to a file instead of delivering it.
+ * It MUST only be used in development and test environments.
+ * If path is empty events are written to standard output.
+ */
+type FilePublisher struct {
+	path string
+	lock *sync.Mutex
+}
+
+func NewFilePublisher(path string) FilePublisher {
+	return FilePublisher{
+		path: path,
+		lock: &sync.Mutex{},
+	}
+}
+
+func (f FilePublisher) PublishUserDeleted(userDeleted domain.UserDeleted) error {
+	return f.publish(event{
+		Type:    UserDeletedEvent,
+		Payload: userDeleted,
+	})
+}
+
+func (f FilePublisher) publish(e event) error {
+	line, err := json.Marshal(e)
+	switch err != nil {
+	case true:
+		return err
+	}
+	line = append(line, '\n')
+	switch f.path == "" {
+	case true:
+		_, err = os.Stdout.Write(line)
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	switch err != nil {
+	case true:
+		return err
+	}
+	_, err = file.Write(line)
+	switch err != nil {
+	case true:
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
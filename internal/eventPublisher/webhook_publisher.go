@@ -0,0 +1,82 @@
+package eventPublisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/zytell3301/tg-users-service/internal/domain"
+	"net/http"
+	"time"
+)
+
+/**
+ * WebhookPublisher posts events to an http endpoint of a message broker gateway.
+ * Request body is a json object with type and payload fields.
+ * Any response status out of 2xx range is considered as a delivery failure.
+ */
+type WebhookPublisher struct {
+	url           string
+	authorization string
+	client        *http.Client
+}
+
+type WebhookConfigs struct {
+	Url string
+	// Optional value for Authorization header of the requests
+	Authorization string
+	Timeout       time.Duration
+}
+
+type UnexpectedStatus struct {
+	Status int
+}
+
+func (e UnexpectedStatus) Error() string {
+	return fmt.Sprintf("webhook responded with unexpected status %d", e.Status)
+}
+
+func NewWebhookPublisher(configs WebhookConfigs) WebhookPublisher {
+	return WebhookPublisher{
+		url:           configs.Url,
+		authorization: configs.Authorization,
+		client: &http.Client{
+			Timeout: configs.Timeout,
+		},
+	}
+}
+
+func (w WebhookPublisher) PublishUserDeleted(userDeleted domain.UserDeleted) error {
+	return w.publish(event{
+		Type:    UserDeletedEvent,
+		Payload: userDeleted,
+	})
+}
+
+func (w WebhookPublisher) publish(e event) error {
+	body, err := json.Marshal(e)
+	switch err != nil {
+	case true:
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	switch err != nil {
+	case true:
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	switch w.authorization != "" {
+	case true:
+		request.Header.Set("Authorization", w.authorization)
+	}
+	response, err := w.client.Do(request)
+	switch err != nil {
+	case true:
+		return err
+	}
+	defer response.Body.Close()
+	switch response.StatusCode < 200 || response.StatusCode > 299 {
+	case true:
+		return UnexpectedStatus{Status: response.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package grpcHandlers
+
+import (
+	"crypto/x509"
+	"errors"
+)
+
+/**
+ * Admin certificates of other services. Admin certificates are issued by a dedicated admin CA that never signs user
+ * certificates, so a user certificate is never taken as an admin certificate even if its common name is an admin
+ * identity. Admin certificates must be signed directly by the admin CA and have clientAuth extended key usage.
+ */
+type Admins struct {
+	pool       *x509.CertPool
+	identities map[string]bool
+	// Decimal serial numbers of admin certificates that are revoked by the admin CA
+	revoked map[string]bool
+}
+
+/**
+ * Creates admins from the certificate of admin CA and its optional revocation list in PEM or DER format.
+ * Signature of the revocation list is verified by the admin CA. If caCert is nil no certificate is an admin certificate.
+ */
+func NewAdmins(caCert *x509.Certificate, revocationList []byte, identities []string) (Admins, error) {
+	admins := Admins{
+		pool:       x509.NewCertPool(),
+		identities: make(map[string]bool, len(identities)),
+		revoked:    map[string]bool{},
+	}
+	switch caCert == nil {
+	case true:
+		return admins, nil
+	}
+	admins.pool.AddCert(caCert)
+	for _, identity := range identities {
+		admins.identities[identity] = true
+	}
+	switch len(revocationList) == 0 {
+	case true:
+		return admins, nil
+	}
+	crl, err := x509.ParseCRL(revocationList)
+	switch err != nil {
+	case true:
+		return Admins{}, err
+	}
+	err = caCert.CheckCRLSignature(crl)
+	switch err != nil {
+	case true:
+		return Admins{}, errors.New("revocation list is not signed by admin CA")
+	}
+	for _, revoked := range crl.TBSCertList.RevokedCertificates {
+		admins.revoked[revoked.SerialNumber.String()] = true
+	}
+	return admins, nil
+}
+
+/**
+ * Checks that the certificate is issued by admin CA for one of the admin identities
+ */
+func (a Admins) isAdmin(cert *x509.Certificate) bool {
+	switch a.identities[cert.Subject.CommonName] {
+	case false:
+		return false
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     a.pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
+
+func (a Admins) isRevoked(cert *x509.Certificate) bool {
+	return a.revoked[cert.SerialNumber.String()]
+}
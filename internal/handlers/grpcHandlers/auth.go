@@ -0,0 +1,203 @@
+package grpcHandlers
+
+import (
+	"context"
+	"errors"
+	errors2 "github.com/zytell3301/tg-globals/errors"
+	"github.com/zytell3301/tg-users-service/internal/core"
+	"github.com/zytell3301/tg-users-service/pkg/UsersService"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/**
+ * Identity of the caller that is extracted from its verified client certificate
+ */
+type identity struct {
+	// Id of the authenticated user. It is empty for admins
+	userId string
+	// Decimal serial number of the client certificate of the caller
+	serial  string
+	isAdmin bool
+}
+
+type identityKey struct{}
+
+/**
+ * Rpcs that act on the authenticated user
+ */
+var userMethods = map[string]bool{
+	fullMethod("Logout"):                           true,
+	fullMethod("ListSessions"):                     true,
+	fullMethod("TerminateSession"):                 true,
+	fullMethod("TerminateAllOtherSessions"):        true,
+	fullMethod("DeleteUser"):                       true,
+	fullMethod("UpdateUsername"):                   true,
+	fullMethod("UpdateProfile"):                    true,
+	fullMethod("RequestChangePhoneSecurityCode"):   true,
+	fullMethod("ChangePhone"):                      true,
+	fullMethod("RequestDeleteAccountSecurityCode"): true,
+	fullMethod("CancelAccountDeletion"):            true,
+	fullMethod("SetAccountTTL"):                    true,
+	fullMethod("GetAccountTTL"):                    true,
+	fullMethod("SetPassword"):                      true,
+	fullMethod("ChangePassword"):                   true,
+	fullMethod("DisablePassword"):                  true,
+	fullMethod("RequestRecoveryEmailCode"):         true,
+	fullMethod("VerifyRecoveryEmail"):              true,
+	fullMethod("SearchUsers"):                      true,
+	fullMethod("SetOnline"):                        true,
+	fullMethod("SetOffline"):                       true,
+	fullMethod("Heartbeat"):                        true,
+	fullMethod("SubscribePresence"):                true,
+	fullMethod("SetPrivacyRule"):                   true,
+	fullMethod("GetPrivacyRules"):                  true,
+	fullMethod("ImportContacts"):                   true,
+	fullMethod("GetContacts"):                      true,
+	fullMethod("DeleteContacts"):                   true,
+	fullMethod("GetContactsHash"):                  true,
+}
+
+/**
+ * Rpcs that are only available to other services
+ */
+var adminMethods = map[string]bool{
+	fullMethod("AdminDeleteUser"):     true,
+	fullMethod("AdminUpdateUsername"): true,
+	fullMethod("RevokeCertificate"):   true,
+}
+
+/**
+ * Rpcs that return users. Authentication is optional and privacy rules of the returned users are applied
+ * for users and anonymous callers
+ */
+var viewerMethods = map[string]bool{
+	fullMethod("GetUserByUsername"): true,
+	fullMethod("GetUserById"):       true,
+	fullMethod("GetUsersByIds"):     true,
+	fullMethod("GetUserByPhone"):    true,
+}
+
+func fullMethod(method string) string {
+	return "/" + UsersService.UsersService_ServiceDesc.ServiceName + "/" + method
+}
+
+/**
+ * Creates an interceptor that authenticates callers by their client certificate which is verified by tls layer.
+ * Certificates that are issued by admin CA for an admin identity are admin certificates of other services, unless
+ * admin CA has revoked them. Other certificates must be user certificates issued by the service that are not revoked.
+ * Rpcs that are not user or admin rpcs are not restricted. Unrestricted rpcs are the ones that are called before
+ * a certificate is issued, like signup and login, and revocation status rpcs, since revocation status of
+ * certificates is public to every party that verifies them.
+ */
+func NewAuthInterceptor(service core.Service, admins Admins) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, info.FullMethod, service, admins)
+		switch err != nil {
+		case true:
+			return nil, err
+		}
+		return handler(ctx, request)
+	}
+}
+
+/**
+ * Stream version of NewAuthInterceptor. Identity of the caller is available in context of the stream
+ */
+func NewAuthStreamInterceptor(service core.Service, admins Admins) grpc.StreamServerInterceptor {
+	return func(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), info.FullMethod, service, admins)
+		switch err != nil {
+		case true:
+			return err
+		}
+		return handler(server, authenticatedStream{
+			ServerStream: stream,
+			ctx:          ctx,
+		})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (a authenticatedStream) Context() context.Context {
+	return a.ctx
+}
+
+/**
+ * Returns a context that contains identity of the caller if the method is a user, admin or viewer rpc.
+ * Callers of viewer rpcs without a certificate are anonymous.
+ */
+func authenticate(ctx context.Context, method string, service core.Service, admins Admins) (context.Context, error) {
+	switch userMethods[method] || adminMethods[method] || viewerMethods[method] {
+	case false:
+		return ctx, nil
+	}
+	cert := peerCertificate(ctx)
+	switch {
+	case cert == nil && viewerMethods[method]:
+		return ctx, nil
+	case cert == nil:
+		return nil, status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+	caller := identity{
+		serial: cert.SerialNumber.String(),
+	}
+	switch admins.isAdmin(cert) {
+	case true:
+		switch admins.isRevoked(cert) {
+		case true:
+			return nil, status.Error(codes.Unauthenticated, core.CertificateNotValidError.Message)
+		}
+		caller.isAdmin = true
+	default:
+		userId, err := service.Authenticate(cert)
+		switch {
+		case errors.As(err, &errors2.InternalError{}):
+			return nil, status.Error(codes.Internal, errors2.InternalErrorOccurred.Message)
+		case err != nil:
+			return nil, status.Error(codes.Unauthenticated, core.CertificateNotValidError.Message)
+		}
+		caller.userId = userId
+	}
+	switch {
+	case userMethods[method] && caller.isAdmin, adminMethods[method] && !caller.isAdmin:
+		return nil, status.Error(codes.PermissionDenied, "caller is not allowed to call "+method)
+	}
+	switch caller.isAdmin {
+	case false:
+		service.RecordActivity(caller.userId)
+	}
+	return context.WithValue(ctx, identityKey{}, caller), nil
+}
+
+/**
+ * Returns the caller of a viewer rpc
+ */
+func callerViewer(ctx context.Context) core.Viewer {
+	caller, _ := ctx.Value(identityKey{}).(identity)
+	return core.Viewer{
+		UserId:  caller.userId,
+		IsAdmin: caller.isAdmin,
+	}
+}
+
+/**
+ * Returns id of the authenticated user. Only usable in user rpcs
+ */
+func authenticatedUserId(ctx context.Context) string {
+	caller, _ := ctx.Value(identityKey{}).(identity)
+	return caller.userId
+}
+
+/**
+ * Returns serial of the verified client certificate of the authenticated user. Only usable in user rpcs
+ */
+func authenticatedSerial(ctx context.Context) string {
+	caller, _ := ctx.Value(identityKey{}).(identity)
+	return caller.serial
+}
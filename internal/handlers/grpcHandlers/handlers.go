@@ -8,6 +8,7 @@ import (
 	"github.com/zytell3301/tg-users-service/internal/domain"
 	"github.com/zytell3301/tg-users-service/pkg/UsersService"
 	error1 "github.com/zytell3301/tg-users-service/pkg/error"
+	"math"
 	"time"
 )
 
@@ -30,10 +31,12 @@ func (h Handler) NewUser(ctx context.Context, message *UsersService.NewUserMessa
 	err := h.core.NewUser(domain.User{
 		Name:       message.User.Name,
 		Lastname:   message.User.Lastname,
+		Bio:        message.User.Bio,
 		Phone:      message.User.Phone,
 		Created_at: time.Now(),
 	}, message.SecurityCode.Code)
 
+	profileNotValid := core.ProfileNotValid{}
 	switch {
 	case errors.As(err, &core.UserAlreadyExists{}):
 		return &error1.Error{
@@ -45,6 +48,18 @@ func (h Handler) NewUser(ctx context.Context, message *UsersService.NewUserMessa
 			Message: core.SecurityCodeNotValidError.Message,
 			Code:    core.SecurityCodeNotValidError.Code,
 		}, nil
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &error1.Error{
+			Message: core.SecurityCodeAttemptsExceededError.Message,
+			Code:    core.SecurityCodeAttemptsExceededError.Code,
+		}, nil
+	case errors.As(err, &profileNotValid):
+		return newProfileNotValidError(profileNotValid), nil
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &error1.Error{
+			Message: core.PhoneNotValidError.Message,
+			Code:    core.PhoneNotValidError.Code,
+		}, nil
 	case errors.As(err, &errors2.InternalError{}):
 		return &error1.Error{
 			Message: errors2.InternalErrorOccurred.Message,
@@ -57,101 +72,438 @@ func (h Handler) NewUser(ctx context.Context, message *UsersService.NewUserMessa
 	}, nil
 }
 
-func (h Handler) DeleteUser(ctx context.Context, phone *UsersService.Phone) (*error1.Error, error) {
-	err := h.core.DeleteUser(phone.Phone)
+func (h Handler) DeleteUser(ctx context.Context, request *UsersService.DeleteUserRequest) (*UsersService.DeleteUserResponse, error) {
+	deleteAt, err := h.core.DeleteUser(authenticatedUserId(ctx), request.GetSecurityCode().GetCode())
+	switch {
+	case errors.As(err, &core.SecurityCodeNotValid{}) || errors.As(err, &core.SecurityCodeActionDoesNotMatch{}):
+		return &UsersService.DeleteUserResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeNotValidError.Message,
+				Code:    core.SecurityCodeNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &UsersService.DeleteUserResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeAttemptsExceededError.Message,
+				Code:    core.SecurityCodeAttemptsExceededError.Code,
+			},
+		}, nil
+	case err != nil:
+		return &UsersService.DeleteUserResponse{
+			Error: newDeleteUserResponse(err),
+		}, nil
+	}
+	response := &UsersService.DeleteUserResponse{
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}
+	switch deleteAt.IsZero() {
+	case false:
+		response.DeleteAt = deleteAt.Unix()
+	}
+	return response, nil
+}
+
+func (h Handler) RequestDeleteAccountSecurityCode(ctx context.Context, _ *UsersService.RequestDeleteAccountSecurityCodeRequest) (*UsersService.RequestSecurityCodeResponse, error) {
+	err := h.core.RequestDeleteAccountSecurityCode(authenticatedUserId(ctx), callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserNotFound{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.UserNotFoundError.Message,
+				Code:    core.UserNotFoundError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.SecurityCodeDeliveryFailed{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeDeliveryFailedError.Message,
+				Code:    core.SecurityCodeDeliveryFailedError.Code,
+			},
+		}, nil
+	case errors.As(err, &tooManyRequests):
+		return newTooManyRequestsResponse(tooManyRequests), nil
+	}
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
 
+func (h Handler) CancelAccountDeletion(ctx context.Context, _ *UsersService.CancelAccountDeletionRequest) (*error1.Error, error) {
+	err := h.core.CancelAccountDeletion(authenticatedUserId(ctx))
 	switch {
 	case errors.As(err, &errors2.InternalError{}):
 		return &error1.Error{
 			Message: errors2.InternalErrorOccurred.Message,
 			Code:    errors2.InternalErrorOccurred.Code,
 		}, nil
+	case errors.As(err, &core.AccountDeletionNotScheduled{}):
+		return &error1.Error{
+			Message: core.AccountDeletionNotScheduledError.Message,
+			Code:    core.AccountDeletionNotScheduledError.Code,
+		}, nil
 	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
 
+func (h Handler) SetAccountTTL(ctx context.Context, request *UsersService.SetAccountTTLRequest) (*error1.Error, error) {
+	err := h.core.SetAccountTTL(authenticatedUserId(ctx), int(request.GetDays()))
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.AccountTTLNotValid{}):
+		return &error1.Error{
+			Message: core.AccountTTLNotValidError.Message,
+			Code:    core.AccountTTLNotValidError.Code,
+		}, nil
+	}
 	return &error1.Error{
 		Code: 0,
 	}, nil
 }
 
-func (h Handler) UpdateUsername(ctx context.Context, message *UsersService.UpdateUsernameMessage) (*error1.Error, error) {
-	err := h.core.UpdateUsername(message.Phone, message.Username)
+func (h Handler) GetAccountTTL(ctx context.Context, _ *UsersService.GetAccountTTLRequest) (*UsersService.GetAccountTTLResponse, error) {
+	days, err := h.core.GetAccountTTL(authenticatedUserId(ctx))
+	switch err != nil {
+	case true:
+		return &UsersService.GetAccountTTLResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	return &UsersService.GetAccountTTLResponse{
+		Days: int32(days),
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) SetOnline(ctx context.Context, _ *UsersService.SetOnlineRequest) (*error1.Error, error) {
+	return newPresenceErrorResponse(h.core.SetOnline(authenticatedUserId(ctx))), nil
+}
+
+func (h Handler) SetOffline(ctx context.Context, _ *UsersService.SetOfflineRequest) (*error1.Error, error) {
+	return newPresenceErrorResponse(h.core.SetOffline(authenticatedUserId(ctx))), nil
+}
+
+func (h Handler) Heartbeat(ctx context.Context, _ *UsersService.HeartbeatRequest) (*error1.Error, error) {
+	return newPresenceErrorResponse(h.core.Heartbeat(authenticatedUserId(ctx))), nil
+}
 
+func newPresenceErrorResponse(err error) *error1.Error {
 	switch {
-	case errors.As(err, &core.UsernameNotQualified{}):
+	case errors.As(err, &core.UserNotFound{}):
 		return &error1.Error{
-			Message: core.UsernameNotQualifiedError.Message,
-			Code:    core.UsernameNotQualifiedError.Code,
-		}, nil
+			Message: core.UserNotFoundError.Message,
+			Code:    core.UserNotFoundError.Code,
+		}
+	case err != nil:
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}
+	}
+	return &error1.Error{
+		Code: 0,
+	}
+}
 
-	case errors.As(err, &core.UsernameAlreadyExists{}):
+func (h Handler) SetPrivacyRule(ctx context.Context, request *UsersService.SetPrivacyRuleRequest) (*error1.Error, error) {
+	rule := request.GetRule()
+	err := h.core.SetPrivacyRule(authenticatedUserId(ctx), domain.PrivacyRule{
+		Rule:       rule.GetRule(),
+		Visibility: rule.GetVisibility(),
+		AllowUsers: rule.GetAllowUsers(),
+		DenyUsers:  rule.GetDenyUsers(),
+	})
+	switch {
+	case errors.As(err, &core.PrivacyRuleNotValid{}):
 		return &error1.Error{
-			Message: core.UsernameAlreadyExistsError.Message,
-			Code:    core.UsernameAlreadyExistsError.Code,
+			Message: core.PrivacyRuleNotValidError.Message,
+			Code:    core.PrivacyRuleNotValidError.Code,
+		}, nil
+	case err != nil:
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
 		}, nil
 	}
-
 	return &error1.Error{
 		Code: 0,
 	}, nil
 }
 
-func (h Handler) Login(_ context.Context, request *UsersService.LoginRequest) (*UsersService.LoginResponse, error) {
-	cert, err := h.core.Login(request.Phone, request.SecurityCode.Code)
+func (h Handler) GetPrivacyRules(ctx context.Context, _ *UsersService.GetPrivacyRulesRequest) (*UsersService.GetPrivacyRulesResponse, error) {
+	rules, err := h.core.GetPrivacyRules(authenticatedUserId(ctx))
+	switch err != nil {
+	case true:
+		return &UsersService.GetPrivacyRulesResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	messages := make([]*UsersService.PrivacyRule, 0, len(rules))
+	for _, rule := range rules {
+		messages = append(messages, &UsersService.PrivacyRule{
+			Rule:       rule.Rule,
+			Visibility: rule.Visibility,
+			AllowUsers: rule.AllowUsers,
+			DenyUsers:  rule.DenyUsers,
+		})
+	}
+	return &UsersService.GetPrivacyRulesResponse{
+		Rules: messages,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) ImportContacts(ctx context.Context, request *UsersService.ImportContactsRequest) (*UsersService.ImportContactsResponse, error) {
+	contacts := make([]domain.Contact, 0, len(request.GetContacts()))
+	for _, contact := range request.GetContacts() {
+		contacts = append(contacts, domain.Contact{
+			Phone:    contact.GetPhone(),
+			Name:     contact.GetName(),
+			Lastname: contact.GetLastname(),
+		})
+	}
+	imported, notFound, err := h.core.ImportContacts(authenticatedUserId(ctx), contacts)
+	tooManyRequests := core.TooManyRequests{}
 	switch {
-	case errors.As(err, &core.SecurityCodeNotValid{}):
-		return &UsersService.LoginResponse{
+	case errors.As(err, &core.ContactsBatchTooLarge{}):
+		return &UsersService.ImportContactsResponse{
 			Error: &error1.Error{
-				Message: core.SecurityCodeNotValidError.Message,
-				Code:    core.SecurityCodeNotValidError.Code,
+				Message: core.ContactsBatchTooLargeError.Message,
+				Code:    core.ContactsBatchTooLargeError.Code,
 			},
 		}, nil
-	case errors.As(err, &errors2.InternalError{}):
-		return &UsersService.LoginResponse{
+	case errors.As(err, &tooManyRequests):
+		return &UsersService.ImportContactsResponse{
+			Error: &error1.Error{
+				Message: core.TooManyRequestsError.Message,
+				Code:    core.TooManyRequestsError.Code,
+			},
+			RetryAfter: int64(math.Ceil(tooManyRequests.RetryAfter.Seconds())),
+		}, nil
+	case err != nil:
+		return &UsersService.ImportContactsResponse{
 			Error: &error1.Error{
 				Message: errors2.InternalErrorOccurred.Message,
 				Code:    errors2.InternalErrorOccurred.Code,
 			},
 		}, nil
-	case errors.As(err, &core.UserNotFound{}):
-		return &UsersService.LoginResponse{
+	}
+	return &UsersService.ImportContactsResponse{
+		Contacts:       newContactMessages(imported),
+		NotFoundPhones: notFound,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) GetContacts(ctx context.Context, _ *UsersService.GetContactsRequest) (*UsersService.GetContactsResponse, error) {
+	contacts, hash, err := h.core.GetContacts(authenticatedUserId(ctx))
+	switch err != nil {
+	case true:
+		return &UsersService.GetContactsResponse{
 			Error: &error1.Error{
-				Message: core.UserNotFoundError.Message,
-				Code:    core.UserNotFoundError.Code,
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
 			},
 		}, nil
 	}
-	return &UsersService.LoginResponse{
-		Certificate: cert,
+	return &UsersService.GetContactsResponse{
+		Contacts: newContactMessages(contacts),
+		Hash:     hash,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) DeleteContacts(ctx context.Context, request *UsersService.DeleteContactsRequest) (*error1.Error, error) {
+	err := h.core.DeleteContacts(authenticatedUserId(ctx), request.GetUserIds())
+	switch err != nil {
+	case true:
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+func (h Handler) GetContactsHash(ctx context.Context, _ *UsersService.GetContactsHashRequest) (*UsersService.GetContactsHashResponse, error) {
+	hash, err := h.core.GetContactsHash(authenticatedUserId(ctx))
+	switch err != nil {
+	case true:
+		return &UsersService.GetContactsHashResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	return &UsersService.GetContactsHashResponse{
+		Hash: hash,
+		Error: &error1.Error{
+			Code: 0,
+		},
 	}, nil
 }
 
-func (h Handler) RequestSignupSecurityCode(_ context.Context, request *UsersService.Phone) (*error1.Error, error) {
-	err := h.core.RequestSignupSecurityCode(request.Phone)
+func newContactMessages(contacts []domain.Contact) []*UsersService.Contact {
+	messages := make([]*UsersService.Contact, 0, len(contacts))
+	for _, contact := range contacts {
+		messages = append(messages, &UsersService.Contact{
+			UserId:   contact.UserId,
+			Phone:    contact.Phone,
+			Name:     contact.Name,
+			Lastname: contact.Lastname,
+			IsMutual: contact.IsMutual,
+		})
+	}
+	return messages
+}
+
+/**
+ * Pending updates are taken whenever the stream is able to send, so updates of a slow subscriber are coalesced
+ * by core. Subscription is closed when the stream context is done.
+ */
+func (h Handler) SubscribePresence(request *UsersService.SubscribePresenceRequest, stream UsersService.UsersService_SubscribePresenceServer) error {
+	subscription, err := h.core.SubscribePresence(callerViewer(stream.Context()), request.GetUserIds())
+	switch {
+	case errors.As(err, &core.UsersBatchTooLarge{}):
+		return stream.Send(&UsersService.SubscribePresenceResponse{
+			Error: &error1.Error{
+				Message: core.UsersBatchTooLargeError.Message,
+				Code:    core.UsersBatchTooLargeError.Code,
+			},
+		})
+	case err != nil:
+		return stream.Send(&UsersService.SubscribePresenceResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		})
+	}
+	defer h.core.UnsubscribePresence(subscription)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-subscription.Notify():
+			updates := subscription.Updates()
+			switch len(updates) == 0 {
+			case true:
+				continue
+			}
+			err = stream.Send(newSubscribePresenceResponse(updates))
+			switch err != nil {
+			case true:
+				return err
+			}
+		}
+	}
+}
+
+func newSubscribePresenceResponse(updates []domain.PresenceUpdate) *UsersService.SubscribePresenceResponse {
+	messages := make([]*UsersService.PresenceUpdate, 0, len(updates))
+	for _, update := range updates {
+		lastSeen := int64(0)
+		switch update.LastSeen.IsZero() {
+		case false:
+			lastSeen = update.LastSeen.Unix()
+		}
+		messages = append(messages, &UsersService.PresenceUpdate{
+			UserId:         update.UserId,
+			OnlineStatus:   update.IsOnline,
+			LastSeen:       lastSeen,
+			LastSeenStatus: UsersService.LastSeenStatus(update.LastSeenStatus),
+		})
+	}
+	return &UsersService.SubscribePresenceResponse{
+		Updates: messages,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}
+}
+
+func (h Handler) AdminDeleteUser(_ context.Context, phone *UsersService.Phone) (*error1.Error, error) {
+	return newDeleteUserResponse(h.core.DeleteUserByPhone(phone.Phone)), nil
+}
+
+func newDeleteUserResponse(err error) *error1.Error {
 	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &error1.Error{
+			Message: core.PhoneNotValidError.Message,
+			Code:    core.PhoneNotValidError.Code,
+		}
 	case errors.As(err, &errors2.InternalError{}):
 		return &error1.Error{
 			Message: errors2.InternalErrorOccurred.Message,
 			Code:    errors2.InternalErrorOccurred.Code,
-		}, nil
-	case errors.As(err, &core.UserAlreadyExists{}):
+		}
+	case errors.As(err, &core.UserNotFound{}):
 		return &error1.Error{
-			Message: core.UserAlreadyExistsError.Message,
-			Code:    core.UserAlreadyExistsError.Code,
-		}, nil
+			Message: core.UserNotFoundError.Message,
+			Code:    core.UserNotFoundError.Code,
+		}
 	}
+
 	return &error1.Error{
 		Code: 0,
-	}, nil
+	}
+}
+
+func (h Handler) UpdateUsername(ctx context.Context, request *UsersService.UpdateUsernameRequest) (*error1.Error, error) {
+	return newUpdateUsernameResponse(h.core.UpdateUsername(authenticatedUserId(ctx), request.Username)), nil
 }
 
-func (h Handler) RequestLoginSecurityCode(_ context.Context, request *UsersService.Phone) (*error1.Error, error) {
-	err := h.core.RequestLoginSecurityCode(request.Phone)
+func (h Handler) UpdateProfile(ctx context.Context, request *UsersService.UpdateProfileRequest) (*error1.Error, error) {
+	err := h.core.UpdateProfile(authenticatedUserId(ctx), domain.User{
+		Name:     request.Name,
+		Lastname: request.Lastname,
+		Bio:      request.Bio,
+	}, request.GetUpdateMask().GetPaths())
+	profileNotValid := core.ProfileNotValid{}
 	switch {
 	case errors.As(err, &errors2.InternalError{}):
 		return &error1.Error{
 			Message: errors2.InternalErrorOccurred.Message,
 			Code:    errors2.InternalErrorOccurred.Code,
 		}, nil
+	case errors.As(err, &profileNotValid):
+		return newProfileNotValidError(profileNotValid), nil
 	case errors.As(err, &core.UserNotFound{}):
 		return &error1.Error{
 			Message: core.UserNotFoundError.Message,
@@ -163,56 +515,798 @@ func (h Handler) RequestLoginSecurityCode(_ context.Context, request *UsersServi
 	}, nil
 }
 
-func (h Handler) VerifySecurityCode(_ context.Context, request *UsersService.VerifySecurityCodeRequest) (*error1.Error, error) {
-	err := h.core.VerifySecurityCode(request.Phone, request.SecurityCode, request.Action)
+/**
+ * Name of the field that is not valid is appended to the message
+ */
+func newProfileNotValidError(err core.ProfileNotValid) *error1.Error {
+	return &error1.Error{
+		Message: core.ProfileNotValidError.Message + ": " + err.Field,
+		Code:    core.ProfileNotValidError.Code,
+	}
+}
+
+func (h Handler) AdminUpdateUsername(_ context.Context, message *UsersService.UpdateUsernameMessage) (*error1.Error, error) {
+	return newUpdateUsernameResponse(h.core.UpdateUsernameByPhone(message.Phone, message.Username)), nil
+}
+
+func newUpdateUsernameResponse(err error) *error1.Error {
 	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &error1.Error{
+			Message: core.PhoneNotValidError.Message,
+			Code:    core.PhoneNotValidError.Code,
+		}
 	case errors.As(err, &errors2.InternalError{}):
 		return &error1.Error{
 			Message: errors2.InternalErrorOccurred.Message,
 			Code:    errors2.InternalErrorOccurred.Code,
-		}, nil
-	case errors.As(err, &core.SecurityCodeNotValid{}):
+		}
+	case errors.As(err, &core.UsernameNotQualified{}):
 		return &error1.Error{
-			Message: core.SecurityCodeNotValidError.Message,
-			Code:    core.SecurityCodeNotValidError.Code,
-		}, nil
-	case errors.As(err, &core.SecurityCodeActionDoesNotMatch{}):
+			Message: core.UsernameNotQualifiedError.Message,
+			Code:    core.UsernameNotQualifiedError.Code,
+		}
+	case errors.As(err, &core.UsernameAlreadyExists{}):
 		return &error1.Error{
-			Message: core.SecurityCodeActionDoesNotMatchError.Message,
-			Code:    core.SecurityCodeActionDoesNotMatchError.Code,
-		}, nil
+			Message: core.UsernameAlreadyExistsError.Message,
+			Code:    core.UsernameAlreadyExistsError.Code,
+		}
+	case errors.As(err, &core.UserNotFound{}):
+		return &error1.Error{
+			Message: core.UserNotFoundError.Message,
+			Code:    core.UserNotFoundError.Code,
+		}
 	}
+
 	return &error1.Error{
 		Code: 0,
-	}, nil
+	}
 }
 
-func (h Handler) GetUserByUsername(_ context.Context, request *UsersService.GetUserByUsernameRequest) (*UsersService.GetUserByUsernameResponse, error) {
-	user, err := h.core.GetUserByUsername(request.Username)
+func (h Handler) Login(ctx context.Context, request *UsersService.LoginRequest) (*UsersService.LoginResponse, error) {
+	cert, err := h.core.Login(request.Phone, request.SecurityCode.Code, request.PublicKey, request.CertificateRequest, domain.Session{
+		Device: request.Device,
+		Ip:     callerIp(ctx),
+	})
+	passwordRequired := core.PasswordRequired{}
 	switch {
+	case errors.As(err, &core.SecurityCodeNotValid{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeNotValidError.Message,
+				Code:    core.SecurityCodeNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.PhoneNotValidError.Message,
+				Code:    core.PhoneNotValidError.Code,
+			},
+		}, nil
 	case errors.As(err, &errors2.InternalError{}):
-		return &UsersService.GetUserByUsernameResponse{
+		return &UsersService.LoginResponse{
 			Error: &error1.Error{
 				Message: errors2.InternalErrorOccurred.Message,
 				Code:    errors2.InternalErrorOccurred.Code,
 			},
 		}, nil
 	case errors.As(err, &core.UserNotFound{}):
-		return &UsersService.GetUserByUsernameResponse{
+		return &UsersService.LoginResponse{
 			Error: &error1.Error{
 				Message: core.UserNotFoundError.Message,
 				Code:    core.UserNotFoundError.Code,
 			},
 		}, nil
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeAttemptsExceededError.Message,
+				Code:    core.SecurityCodeAttemptsExceededError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.PublicKeyNotValid{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.PublicKeyNotValidError.Message,
+				Code:    core.PublicKeyNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.CertificateRequestNotValid{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.CertificateRequestNotValidError.Message,
+				Code:    core.CertificateRequestNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.PublicKeyTooWeak{}):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.PublicKeyTooWeakError.Message,
+				Code:    core.PublicKeyTooWeakError.Code,
+			},
+		}, nil
+	case errors.As(err, &passwordRequired):
+		return &UsersService.LoginResponse{
+			Error: &error1.Error{
+				Message: core.PasswordRequiredError.Message,
+				Code:    core.PasswordRequiredError.Code,
+			},
+			PasswordRequired: true,
+			LoginToken:       passwordRequired.LoginToken,
+			PasswordHint:     passwordRequired.Hint,
+		}, nil
 	}
-	return &UsersService.GetUserByUsernameResponse{
-		User: &UsersService.User{
-			Id:           user.Id,
-			Name:         user.Name,
-			Lastname:     user.Lastname,
-			Bio:          user.Bio,
-			Username:     user.Username,
-			OnlineStatus: user.Online_status,
+	return &UsersService.LoginResponse{
+		Certificate: cert,
+	}, nil
+}
+
+func (h Handler) CheckPassword(_ context.Context, request *UsersService.CheckPasswordRequest) (*UsersService.LoginResponse, error) {
+	cert, err := h.core.CheckPassword(request.GetLoginToken(), request.GetPassword())
+	switch err != nil {
+	case true:
+		return &UsersService.LoginResponse{
+			Error: newPasswordErrorResponse(err),
+		}, nil
+	}
+	return &UsersService.LoginResponse{
+		Certificate: cert,
+	}, nil
+}
+
+func (h Handler) RequestPasswordRecovery(ctx context.Context, request *UsersService.RequestPasswordRecoveryRequest) (*UsersService.RequestPasswordRecoveryResponse, error) {
+	emailPattern, err := h.core.RequestPasswordRecovery(request.GetLoginToken(), callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch {
+	case errors.As(err, &tooManyRequests):
+		return &UsersService.RequestPasswordRecoveryResponse{
+			Error:      newPasswordErrorResponse(err),
+			RetryAfter: int64(math.Ceil(tooManyRequests.RetryAfter.Seconds())),
+		}, nil
+	case err != nil:
+		return &UsersService.RequestPasswordRecoveryResponse{
+			Error: newPasswordErrorResponse(err),
+		}, nil
+	}
+	return &UsersService.RequestPasswordRecoveryResponse{
+		EmailPattern: emailPattern,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) RecoverPassword(_ context.Context, request *UsersService.RecoverPasswordRequest) (*UsersService.LoginResponse, error) {
+	cert, err := h.core.RecoverPassword(request.GetLoginToken(), request.GetSecurityCode().GetCode(), request.GetNewPassword(), request.GetHint())
+	switch err != nil {
+	case true:
+		return &UsersService.LoginResponse{
+			Error: newPasswordErrorResponse(err),
+		}, nil
+	}
+	return &UsersService.LoginResponse{
+		Certificate: cert,
+	}, nil
+}
+
+func (h Handler) RequestRecoveryEmailCode(ctx context.Context, _ *UsersService.RequestRecoveryEmailCodeRequest) (*UsersService.RequestSecurityCodeResponse, error) {
+	err := h.core.RequestRecoveryEmailCode(authenticatedUserId(ctx), callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch errors.As(err, &tooManyRequests) {
+	case true:
+		return newTooManyRequestsResponse(tooManyRequests), nil
+	}
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: newPasswordErrorResponse(err),
+	}, nil
+}
+
+func (h Handler) VerifyRecoveryEmail(ctx context.Context, request *UsersService.VerifyRecoveryEmailRequest) (*error1.Error, error) {
+	return newPasswordErrorResponse(h.core.VerifyRecoveryEmail(authenticatedUserId(ctx), request.GetSecurityCode().GetCode())), nil
+}
+
+func (h Handler) SetPassword(ctx context.Context, request *UsersService.SetPasswordRequest) (*error1.Error, error) {
+	return newPasswordErrorResponse(h.core.SetPassword(authenticatedUserId(ctx), request.GetPassword(), request.GetHint(), request.GetRecoveryEmail())), nil
+}
+
+func (h Handler) ChangePassword(ctx context.Context, request *UsersService.ChangePasswordRequest) (*error1.Error, error) {
+	return newPasswordErrorResponse(h.core.ChangePassword(authenticatedUserId(ctx), request.GetCurrentPassword(), request.GetNewPassword(), request.GetHint(), request.GetRecoveryEmail())), nil
+}
+
+func (h Handler) DisablePassword(ctx context.Context, request *UsersService.DisablePasswordRequest) (*error1.Error, error) {
+	return newPasswordErrorResponse(h.core.DisablePassword(authenticatedUserId(ctx), request.GetCurrentPassword())), nil
+}
+
+/**
+ * Maps errors of two-step verification and password recovery methods
+ */
+func newPasswordErrorResponse(err error) *error1.Error {
+	switch {
+	case err == nil:
+		return &error1.Error{
+			Code: 0,
+		}
+	case errors.As(err, &core.PasswordNotValid{}):
+		return &error1.Error{
+			Message: core.PasswordNotValidError.Message,
+			Code:    core.PasswordNotValidError.Code,
+		}
+	case errors.As(err, &core.PasswordNotQualified{}):
+		return &error1.Error{
+			Message: core.PasswordNotQualifiedError.Message,
+			Code:    core.PasswordNotQualifiedError.Code,
+		}
+	case errors.As(err, &core.EmailNotValid{}):
+		return &error1.Error{
+			Message: core.EmailNotValidError.Message,
+			Code:    core.EmailNotValidError.Code,
+		}
+	case errors.As(err, &core.PasswordAlreadySet{}):
+		return &error1.Error{
+			Message: core.PasswordAlreadySetError.Message,
+			Code:    core.PasswordAlreadySetError.Code,
+		}
+	case errors.As(err, &core.PasswordNotSet{}):
+		return &error1.Error{
+			Message: core.PasswordNotSetError.Message,
+			Code:    core.PasswordNotSetError.Code,
+		}
+	case errors.As(err, &core.TooManyRequests{}):
+		return &error1.Error{
+			Message: core.TooManyRequestsError.Message,
+			Code:    core.TooManyRequestsError.Code,
+		}
+	case errors.As(err, &core.LoginTokenNotValid{}):
+		return &error1.Error{
+			Message: core.LoginTokenNotValidError.Message,
+			Code:    core.LoginTokenNotValidError.Code,
+		}
+	case errors.As(err, &core.UserNotFound{}):
+		return &error1.Error{
+			Message: core.UserNotFoundError.Message,
+			Code:    core.UserNotFoundError.Code,
+		}
+	case errors.As(err, &core.RecoveryEmailNotSet{}):
+		return &error1.Error{
+			Message: core.RecoveryEmailNotSetError.Message,
+			Code:    core.RecoveryEmailNotSetError.Code,
+		}
+	case errors.As(err, &core.RecoveryEmailAlreadyVerified{}):
+		return &error1.Error{
+			Message: core.RecoveryEmailAlreadyVerifiedError.Message,
+			Code:    core.RecoveryEmailAlreadyVerifiedError.Code,
+		}
+	case errors.As(err, &core.SecurityCodeNotValid{}) || errors.As(err, &core.SecurityCodeActionDoesNotMatch{}):
+		return &error1.Error{
+			Message: core.SecurityCodeNotValidError.Message,
+			Code:    core.SecurityCodeNotValidError.Code,
+		}
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &error1.Error{
+			Message: core.SecurityCodeAttemptsExceededError.Message,
+			Code:    core.SecurityCodeAttemptsExceededError.Code,
+		}
+	case errors.As(err, &core.SecurityCodeDeliveryFailed{}):
+		return &error1.Error{
+			Message: core.SecurityCodeDeliveryFailedError.Message,
+			Code:    core.SecurityCodeDeliveryFailedError.Code,
+		}
+	}
+	return &error1.Error{
+		Message: errors2.InternalErrorOccurred.Message,
+		Code:    errors2.InternalErrorOccurred.Code,
+	}
+}
+
+func (h Handler) RequestSignupSecurityCode(ctx context.Context, request *UsersService.Phone) (*UsersService.RequestSecurityCodeResponse, error) {
+	err := h.core.RequestSignupSecurityCode(request.Phone, callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.PhoneNotValidError.Message,
+				Code:    core.PhoneNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserAlreadyExists{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.UserAlreadyExistsError.Message,
+				Code:    core.UserAlreadyExistsError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.SecurityCodeDeliveryFailed{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeDeliveryFailedError.Message,
+				Code:    core.SecurityCodeDeliveryFailedError.Code,
+			},
+		}, nil
+	case errors.As(err, &tooManyRequests):
+		return newTooManyRequestsResponse(tooManyRequests), nil
+	}
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) RequestLoginSecurityCode(ctx context.Context, request *UsersService.Phone) (*UsersService.RequestSecurityCodeResponse, error) {
+	err := h.core.RequestLoginSecurityCode(request.Phone, callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.PhoneNotValidError.Message,
+				Code:    core.PhoneNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserNotFound{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.UserNotFoundError.Message,
+				Code:    core.UserNotFoundError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.SecurityCodeDeliveryFailed{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeDeliveryFailedError.Message,
+				Code:    core.SecurityCodeDeliveryFailedError.Code,
+			},
+		}, nil
+	case errors.As(err, &tooManyRequests):
+		return newTooManyRequestsResponse(tooManyRequests), nil
+	}
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) RequestChangePhoneSecurityCode(ctx context.Context, request *UsersService.Phone) (*UsersService.RequestSecurityCodeResponse, error) {
+	err := h.core.RequestChangePhoneSecurityCode(authenticatedUserId(ctx), request.Phone, callerIp(ctx))
+	tooManyRequests := core.TooManyRequests{}
+	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.PhoneNotValidError.Message,
+				Code:    core.PhoneNotValidError.Code,
+			},
+		}, nil
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserNotFound{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.UserNotFoundError.Message,
+				Code:    core.UserNotFoundError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserAlreadyExists{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.UserAlreadyExistsError.Message,
+				Code:    core.UserAlreadyExistsError.Code,
+			},
+		}, nil
+	case errors.As(err, &core.SecurityCodeDeliveryFailed{}):
+		return &UsersService.RequestSecurityCodeResponse{
+			Error: &error1.Error{
+				Message: core.SecurityCodeDeliveryFailedError.Message,
+				Code:    core.SecurityCodeDeliveryFailedError.Code,
+			},
+		}, nil
+	case errors.As(err, &tooManyRequests):
+		return newTooManyRequestsResponse(tooManyRequests), nil
+	}
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) ChangePhone(ctx context.Context, request *UsersService.ChangePhoneRequest) (*error1.Error, error) {
+	err := h.core.ChangePhone(authenticatedUserId(ctx), request.Phone, request.GetSecurityCode().GetCode(), request.GetCurrentPhoneSecurityCode().GetCode())
+	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &error1.Error{
+			Message: core.PhoneNotValidError.Message,
+			Code:    core.PhoneNotValidError.Code,
+		}, nil
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.UserNotFound{}):
+		return &error1.Error{
+			Message: core.UserNotFoundError.Message,
+			Code:    core.UserNotFoundError.Code,
+		}, nil
+	case errors.As(err, &core.UserAlreadyExists{}):
+		return &error1.Error{
+			Message: core.UserAlreadyExistsError.Message,
+			Code:    core.UserAlreadyExistsError.Code,
+		}, nil
+	case errors.As(err, &core.SecurityCodeNotValid{}) || errors.As(err, &core.SecurityCodeActionDoesNotMatch{}):
+		return &error1.Error{
+			Message: core.SecurityCodeNotValidError.Message,
+			Code:    core.SecurityCodeNotValidError.Code,
+		}, nil
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &error1.Error{
+			Message: core.SecurityCodeAttemptsExceededError.Message,
+			Code:    core.SecurityCodeAttemptsExceededError.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+/**
+ * Retry after is rounded up to seconds so that clients never retry too early
+ */
+func newTooManyRequestsResponse(err core.TooManyRequests) *UsersService.RequestSecurityCodeResponse {
+	return &UsersService.RequestSecurityCodeResponse{
+		Error: &error1.Error{
+			Message: core.TooManyRequestsError.Message,
+			Code:    core.TooManyRequestsError.Code,
+		},
+		RetryAfter: int64(math.Ceil(err.RetryAfter.Seconds())),
+	}
+}
+
+func (h Handler) VerifySecurityCode(_ context.Context, request *UsersService.VerifySecurityCodeRequest) (*error1.Error, error) {
+	err := h.core.VerifySecurityCode(request.Phone, request.SecurityCode, request.Action)
+	switch {
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &error1.Error{
+			Message: core.PhoneNotValidError.Message,
+			Code:    core.PhoneNotValidError.Code,
+		}, nil
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.SecurityCodeNotValid{}):
+		return &error1.Error{
+			Message: core.SecurityCodeNotValidError.Message,
+			Code:    core.SecurityCodeNotValidError.Code,
+		}, nil
+	case errors.As(err, &core.SecurityCodeActionDoesNotMatch{}):
+		return &error1.Error{
+			Message: core.SecurityCodeActionDoesNotMatchError.Message,
+			Code:    core.SecurityCodeActionDoesNotMatchError.Code,
+		}, nil
+	case errors.As(err, &core.SecurityCodeAttemptsExceeded{}):
+		return &error1.Error{
+			Message: core.SecurityCodeAttemptsExceededError.Message,
+			Code:    core.SecurityCodeAttemptsExceededError.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+func (h Handler) GetUserByUsername(ctx context.Context, request *UsersService.GetUserByUsernameRequest) (*UsersService.GetUserByUsernameResponse, error) {
+	user, err := h.core.GetUserByUsername(callerViewer(ctx), request.Username)
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.GetUserByUsernameResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	case errors.As(err, &core.UserNotFound{}):
+		return &UsersService.GetUserByUsernameResponse{
+			Error: &error1.Error{
+				Message: core.UserNotFoundError.Message,
+				Code:    core.UserNotFoundError.Code,
+			},
+		}, nil
+	}
+	return &UsersService.GetUserByUsernameResponse{
+		User: newUserMessage(user),
+	}, nil
+}
+
+func (h Handler) GetUserById(ctx context.Context, request *UsersService.GetUserByIdRequest) (*UsersService.GetUserResponse, error) {
+	return newGetUserResponse(h.core.GetUserById(callerViewer(ctx), request.GetId())), nil
+}
+
+func (h Handler) GetUsersByIds(ctx context.Context, request *UsersService.GetUsersByIdsRequest) (*UsersService.GetUsersByIdsResponse, error) {
+	users, err := h.core.GetUsersByIds(callerViewer(ctx), request.GetIds())
+	switch {
+	case errors.As(err, &core.UsersBatchTooLarge{}):
+		return &UsersService.GetUsersByIdsResponse{
+			Error: &error1.Error{
+				Message: core.UsersBatchTooLargeError.Message,
+				Code:    core.UsersBatchTooLargeError.Code,
+			},
+		}, nil
+	case err != nil:
+		return &UsersService.GetUsersByIdsResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	results := make([]*UsersService.UserLookupResult, 0, len(request.GetIds()))
+	for _, id := range request.GetIds() {
+		result := &UsersService.UserLookupResult{
+			Id: id,
+		}
+		user, isFound := users[id]
+		switch isFound {
+		case true:
+			result.User = newUserMessage(user)
+			result.Found = true
+		}
+		results = append(results, result)
+	}
+	return &UsersService.GetUsersByIdsResponse{
+		Results: results,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) SearchUsers(ctx context.Context, request *UsersService.SearchUsersRequest) (*UsersService.SearchUsersResponse, error) {
+	users, nextPageToken, err := h.core.SearchUsers(callerViewer(ctx), request.GetQuery(), int(request.GetLimit()), request.GetPageToken())
+	switch {
+	case errors.As(err, &core.SearchQueryNotValid{}):
+		return &UsersService.SearchUsersResponse{
+			Error: &error1.Error{
+				Message: core.SearchQueryNotValidError.Message,
+				Code:    core.SearchQueryNotValidError.Code,
+			},
+		}, nil
+	case err != nil:
+		return &UsersService.SearchUsersResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	messages := make([]*UsersService.User, 0, len(users))
+	for _, user := range users {
+		messages = append(messages, newUserMessage(user))
+	}
+	return &UsersService.SearchUsersResponse{
+		Users:         messages,
+		NextPageToken: nextPageToken,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) GetUserByPhone(ctx context.Context, request *UsersService.Phone) (*UsersService.GetUserResponse, error) {
+	return newGetUserResponse(h.core.GetUserByPhone(callerViewer(ctx), request.GetPhone())), nil
+}
+
+func newGetUserResponse(user domain.User, err error) *UsersService.GetUserResponse {
+	switch {
+	case errors.As(err, &core.UserNotFound{}):
+		return &UsersService.GetUserResponse{
+			Error: &error1.Error{
+				Message: core.UserNotFoundError.Message,
+				Code:    core.UserNotFoundError.Code,
+			},
+		}
+	case errors.As(err, &core.PhoneNotValid{}):
+		return &UsersService.GetUserResponse{
+			Error: &error1.Error{
+				Message: core.PhoneNotValidError.Message,
+				Code:    core.PhoneNotValidError.Code,
+			},
+		}
+	case err != nil:
+		return &UsersService.GetUserResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}
+	}
+	return &UsersService.GetUserResponse{
+		User: newUserMessage(user),
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}
+}
+
+/**
+ * Phone number is empty if it is hidden from the caller. Last seen statuses of domain and proto have the same values
+ */
+func newUserMessage(user domain.User) *UsersService.User {
+	lastSeen := int64(0)
+	switch user.Last_seen.IsZero() {
+	case false:
+		lastSeen = user.Last_seen.Unix()
+	}
+	return &UsersService.User{
+		Id:             user.Id,
+		Name:           user.Name,
+		Lastname:       user.Lastname,
+		Bio:            user.Bio,
+		Username:       user.Username,
+		Phone:          user.Phone,
+		OnlineStatus:   user.Online_status,
+		LastSeen:       lastSeen,
+		LastSeenStatus: UsersService.LastSeenStatus(user.Last_seen_status),
+	}
+}
+
+func (h Handler) Logout(ctx context.Context, _ *UsersService.LogoutRequest) (*error1.Error, error) {
+	err := h.core.Logout(authenticatedUserId(ctx), authenticatedSerial(ctx))
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.CertificateNotFound{}):
+		return &error1.Error{
+			Message: core.CertificateNotFoundError.Message,
+			Code:    core.CertificateNotFoundError.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+func (h Handler) RevokeCertificate(_ context.Context, request *UsersService.RevokeCertificateRequest) (*error1.Error, error) {
+	err := h.core.RevokeCertificate(request.UserId, request.Serial)
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.CertificateNotFound{}):
+		return &error1.Error{
+			Message: core.CertificateNotFoundError.Message,
+			Code:    core.CertificateNotFoundError.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+func (h Handler) IsCertificateRevoked(_ context.Context, request *UsersService.IsCertificateRevokedRequest) (*UsersService.IsCertificateRevokedResponse, error) {
+	isRevoked, err := h.core.IsCertificateRevoked(request.Serial)
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.IsCertificateRevokedResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	return &UsersService.IsCertificateRevokedResponse{
+		Revoked: isRevoked,
+		Error: &error1.Error{
+			Code: 0,
 		},
 	}, nil
 }
+
+func (h Handler) GetCertificateRevocationList(_ context.Context, _ *UsersService.GetCertificateRevocationListRequest) (*UsersService.GetCertificateRevocationListResponse, error) {
+	revocationList, err := h.core.GetCertificateRevocationList()
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.GetCertificateRevocationListResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	return &UsersService.GetCertificateRevocationListResponse{
+		RevocationList: revocationList,
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}, nil
+}
+
+func (h Handler) ListSessions(ctx context.Context, _ *UsersService.ListSessionsRequest) (*UsersService.ListSessionsResponse, error) {
+	sessions, err := h.core.ListSessions(authenticatedUserId(ctx))
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &UsersService.ListSessionsResponse{
+			Error: &error1.Error{
+				Message: errors2.InternalErrorOccurred.Message,
+				Code:    errors2.InternalErrorOccurred.Code,
+			},
+		}, nil
+	}
+	response := &UsersService.ListSessionsResponse{
+		Sessions: make([]*UsersService.Session, 0, len(sessions)),
+		Error: &error1.Error{
+			Code: 0,
+		},
+	}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &UsersService.Session{
+			Serial:    session.Serial,
+			Device:    session.Device,
+			Ip:        session.Ip,
+			CreatedAt: session.CreatedAt.Unix(),
+			ExpiresAt: session.ExpiresAt.Unix(),
+		})
+	}
+	return response, nil
+}
+
+func (h Handler) TerminateSession(ctx context.Context, request *UsersService.TerminateSessionRequest) (*error1.Error, error) {
+	err := h.core.TerminateSession(authenticatedUserId(ctx), request.Serial)
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	case errors.As(err, &core.SessionNotFound{}):
+		return &error1.Error{
+			Message: core.SessionNotFoundError.Message,
+			Code:    core.SessionNotFoundError.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
+
+func (h Handler) TerminateAllOtherSessions(ctx context.Context, _ *UsersService.TerminateAllOtherSessionsRequest) (*error1.Error, error) {
+	err := h.core.TerminateAllOtherSessions(authenticatedUserId(ctx), authenticatedSerial(ctx))
+	switch {
+	case errors.As(err, &errors2.InternalError{}):
+		return &error1.Error{
+			Message: errors2.InternalErrorOccurred.Message,
+			Code:    errors2.InternalErrorOccurred.Code,
+		}, nil
+	}
+	return &error1.Error{
+		Code: 0,
+	}, nil
+}
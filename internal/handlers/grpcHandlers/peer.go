@@ -0,0 +1,44 @@
+package grpcHandlers
+
+import (
+	"context"
+	"crypto/x509"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"net"
+)
+
+/**
+ * Returns ip address of the caller from grpc peer info. If peer info is not available empty string is returned
+ */
+func callerIp(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	switch ok && p.Addr != nil {
+	case false:
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	switch err != nil {
+	case true:
+		return p.Addr.String()
+	}
+	return host
+}
+
+/**
+ * Returns the leaf certificate of the first verified chain of the caller. If the caller has not presented
+ * a verified certificate nil is returned
+ */
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	switch ok {
+	case false:
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	switch ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+	case false:
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
@@ -0,0 +1,56 @@
+package mailSender
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+/**
+ * FileSender appends every mail to a file instead of delivering it.
+ * It MUST only be used in development and test environments.
+ * If path is empty mails are written to standard output.
+ */
+type FileSender struct {
+	path      string
+	templates Templates
+	lock      *sync.Mutex
+}
+
+func NewFileSender(path string, templates Templates) FileSender {
+	return FileSender{
+		path:      path,
+		templates: templates,
+		lock:      &sync.Mutex{},
+	}
+}
+
+func (f FileSender) SendMail(email string, code string, action string) error {
+	mail, err := f.templates.render(action, code)
+	switch err != nil {
+	case true:
+		return err
+	}
+	line := fmt.Sprintf("%s %s %s: %s %q\n", time.Now().Format(time.RFC3339), email, action, mail.Subject, mail.Body)
+	switch f.path == "" {
+	case true:
+		_, err = fmt.Print(line)
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	switch err != nil {
+	case true:
+		return err
+	}
+	_, err = file.WriteString(line)
+	switch err != nil {
+	case true:
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,62 @@
+package mailSender
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+/**
+ * SmtpSender delivers mails through an smtp server. If username is set, PLAIN authentication is used
+ * which net/smtp only allows over tls or to localhost.
+ */
+type SmtpSender struct {
+	address   string
+	auth      smtp.Auth
+	from      string
+	templates Templates
+}
+
+type SmtpConfigs struct {
+	Host string
+	Port string
+	// Optional credentials of the smtp server
+	Username string
+	Password string
+	// Sender address of the mails
+	From string
+}
+
+func NewSmtpSender(configs SmtpConfigs, templates Templates) SmtpSender {
+	sender := SmtpSender{
+		address:   net.JoinHostPort(configs.Host, configs.Port),
+		from:      configs.From,
+		templates: templates,
+	}
+	switch configs.Username != "" {
+	case true:
+		sender.auth = smtp.PlainAuth("", configs.Username, configs.Password, configs.Host)
+	}
+	return sender
+}
+
+func (s SmtpSender) SendMail(email string, code string, action string) error {
+	mail, err := s.templates.render(action, code)
+	switch err != nil {
+	case true:
+		return err
+	}
+	switch strings.ContainsAny(email, "\r\n") {
+	case true:
+		return fmt.Errorf("email address %q is not valid", email)
+	}
+	message := "From: " + s.from + "\r\n" +
+		"To: " + email + "\r\n" +
+		"Subject: " + mail.Subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=\"utf-8\"\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(mail.Body, "\n", "\r\n")
+	return smtp.SendMail(s.address, s.auth, s.from, []string{email}, []byte(message))
+}
@@ -0,0 +1,42 @@
+package mailSender
+
+import (
+	"fmt"
+	"strings"
+)
+
+/**
+ * Placeholder that will be replaced with the security code in mail templates
+ */
+const CodePlaceholder = "{code}"
+
+type Template struct {
+	Subject string
+	// Plain text body of the mail. It must contain CodePlaceholder
+	Body string
+}
+
+/**
+ * Templates maps a security code action (VERIFY_RECOVERY_EMAIL, RECOVER_PASSWORD) to its mail template.
+ */
+type Templates map[string]Template
+
+type TemplateNotFound struct {
+	Action string
+}
+
+func (e TemplateNotFound) Error() string {
+	return fmt.Sprintf("no mail template defined for action %s", e.Action)
+}
+
+func (t Templates) render(action string, code string) (Template, error) {
+	template, isset := t[action]
+	switch isset {
+	case false:
+		return Template{}, TemplateNotFound{Action: action}
+	}
+	return Template{
+		Subject: strings.ReplaceAll(template.Subject, CodePlaceholder, code),
+		Body:    strings.ReplaceAll(template.Body, CodePlaceholder, code),
+	}, nil
+}
@@ -8,17 +8,34 @@ import (
 	"github.com/zytell3301/tg-users-service/internal/domain"
 	"github.com/zytell3301/tg-users-service/internal/errorReporter"
 	uuid_generator "github.com/zytell3301/uuid-generator"
+	"sort"
+	"strings"
 	"time"
 )
 
 type Repository struct {
-	usersMetadata           cassandraQB.TableMetadata
-	usersPkPhoneMetadata    cassandraQB.TableMetadata
-	usersPkUsernameMetadata cassandraQB.TableMetadata
-	securityCodesMetaData   cassandraQB.TableMetadata
-	connection              cassandraQB.Connection
-	idGenerator             *uuid_generator.Generator
-	consistencyLevels       ConsistencyLevels
+	usersMetadata                cassandraQB.TableMetadata
+	usersPkPhoneMetadata         cassandraQB.TableMetadata
+	usersPkUsernameMetadata      cassandraQB.TableMetadata
+	securityCodesMetaData        cassandraQB.TableMetadata
+	securityCodeRequestsMetadata cassandraQB.TableMetadata
+	userCertificatesMetadata     cassandraQB.TableMetadata
+	revokedCertificatesMetadata  cassandraQB.TableMetadata
+	sessionsMetadata             cassandraQB.TableMetadata
+	accountDeletionsMetadata     cassandraQB.TableMetadata
+	accountActivitiesMetadata    cassandraQB.TableMetadata
+	passwordsMetadata            cassandraQB.TableMetadata
+	pendingLoginsMetadata        cassandraQB.TableMetadata
+	usersSearchMetadata          cassandraQB.TableMetadata
+	usersSearchTokensMetadata    cassandraQB.TableMetadata
+	privacyRulesMetadata         cassandraQB.TableMetadata
+	contactsMetadata             cassandraQB.TableMetadata
+	contactsReverseMetadata      cassandraQB.TableMetadata
+	emailCodesMetadata           cassandraQB.TableMetadata
+	contactImportsMetadata       cassandraQB.TableMetadata
+	connection                   cassandraQB.Connection
+	idGenerator                  *uuid_generator.Generator
+	consistencyLevels            ConsistencyLevels
 }
 
 type Configs struct {
@@ -31,13 +48,61 @@ type Configs struct {
 type ConsistencyLevels struct {
 	NewUser            gocql.Consistency
 	UpdateUsername     gocql.Consistency
+	UpdateProfile      gocql.Consistency
+	ChangePhone        gocql.Consistency
 	DeleteUser         gocql.Consistency
 	DoesUserExists     gocql.Consistency
 	DoesUsernameExists gocql.Consistency
 	GetUserByUsername  gocql.Consistency
 	GetUserByPhone     gocql.Consistency
+	GetUserById        gocql.Consistency
 	RecordSecurityCode gocql.Consistency
 	GetSecurityCode    gocql.Consistency
+	// Consistency level of the conditional update. Serial consistency is used for the condition itself
+	IncrementSecurityCodeAttempts gocql.Consistency
+	DeleteSecurityCode            gocql.Consistency
+	// Consistency level of the conditional delete. Serial consistency is used for the condition itself
+	ConsumeSecurityCode           gocql.Consistency
+	RecordSecurityCodeRequest     gocql.Consistency
+	GetSecurityCodeRequests       gocql.Consistency
+	RecordCertificate             gocql.Consistency
+	GetUserCertificates           gocql.Consistency
+	RevokeCertificates            gocql.Consistency
+	IsCertificateRevoked          gocql.Consistency
+	GetRevokedCertificates        gocql.Consistency
+	NewSession                    gocql.Consistency
+	GetUserSessions               gocql.Consistency
+	DeleteSessions                gocql.Consistency
+	ScheduleAccountDeletion       gocql.Consistency
+	GetAccountDeletion            gocql.Consistency
+	CancelAccountDeletion         gocql.Consistency
+	GetDueAccountDeletions        gocql.Consistency
+	RecordAccountActivity         gocql.Consistency
+	SetAccountTTL                 gocql.Consistency
+	GetAccountTTL                 gocql.Consistency
+	GetAccountActivities          gocql.Consistency
+	DeleteAccountActivity         gocql.Consistency
+	SetPassword                   gocql.Consistency
+	GetPassword                   gocql.Consistency
+	DeletePassword                gocql.Consistency
+	RecordPendingLogin            gocql.Consistency
+	GetPendingLogin               gocql.Consistency
+	DeletePendingLogin            gocql.Consistency
+	GetUsersByIds                 gocql.Consistency
+	IndexUser                     gocql.Consistency
+	SearchUsers                   gocql.Consistency
+	SetOnlineStatus               gocql.Consistency
+	ExpireOnlineStatus            gocql.Consistency
+	SetPrivacyRule                gocql.Consistency
+	GetPrivacyRules               gocql.Consistency
+	GetUserIdsByPhones            gocql.Consistency
+	AddContacts                   gocql.Consistency
+	GetContacts                   gocql.Consistency
+	DeleteContacts                gocql.Consistency
+	GetContactOwners              gocql.Consistency
+	GetReverseContacts            gocql.Consistency
+	RecordContactImports          gocql.Consistency
+	GetContactImports             gocql.Consistency
 }
 
 var usersMetadata = cassandraQB.TableMetadata{
@@ -52,6 +117,7 @@ var usersMetadata = cassandraQB.TableMetadata{
 		"username":      {},
 		"phone":         {},
 		"online_status": {},
+		"last_seen":     {},
 		"created_at":    {},
 	},
 	Ck:         nil,
@@ -88,11 +154,225 @@ var securityCodesMetaData = cassandraQB.TableMetadata{
 	Pk:       map[string]struct{}{"phone": {}},
 	Table:    "security_codes",
 	Columns: map[string]struct{}{
-		"phone": {},
-		"code":  {},
+		"phone":    {},
+		"code":     {},
+		"action":   {},
+		"attempts": {},
+		"user_id":  {},
+	},
+}
+
+var securityCodeRequestsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"key": {}},
+	Ck:       map[string]struct{}{"requested_at": {}},
+	Table:    "security_code_requests",
+	Columns: map[string]struct{}{
+		"key":          {},
+		"requested_at": {},
+	},
+}
+
+var userCertificatesMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Ck:       map[string]struct{}{"serial": {}},
+	Table:    "user_certificates",
+	Columns: map[string]struct{}{
+		"user_id":    {},
+		"serial":     {},
+		"issued_at":  {},
+		"expires_at": {},
+		"revoked_at": {},
+	},
+}
+
+var revokedCertificatesMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"serial": {}},
+	Table:    "revoked_certificates",
+	Columns: map[string]struct{}{
+		"serial":     {},
+		"user_id":    {},
+		"revoked_at": {},
+		"expires_at": {},
+	},
+}
+
+var sessionsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Ck:       map[string]struct{}{"serial": {}},
+	Table:    "sessions",
+	Columns: map[string]struct{}{
+		"user_id":    {},
+		"serial":     {},
+		"device":     {},
+		"ip":         {},
+		"created_at": {},
+		"expires_at": {},
+	},
+}
+
+var accountDeletionsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Table:    "account_deletions",
+	Columns: map[string]struct{}{
+		"user_id":      {},
+		"scheduled_at": {},
+		"delete_at":    {},
+	},
+}
+
+var accountActivitiesMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Table:    "account_activities",
+	Columns: map[string]struct{}{
+		"user_id":       {},
+		"last_activity": {},
+		"ttl_days":      {},
+	},
+}
+
+var passwordsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Table:    "passwords",
+	Columns: map[string]struct{}{
+		"user_id":                 {},
+		"password_hash":           {},
+		"hint":                    {},
+		"recovery_email":          {},
+		"recovery_email_verified": {},
+		"updated_at":              {},
+	},
+}
+
+var pendingLoginsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"token_hash": {}},
+	Table:    "pending_logins",
+	Columns: map[string]struct{}{
+		"token_hash": {},
+		"user_id":    {},
+		"public_key": {},
+		"device":     {},
+		"ip":         {},
+		"created_at": {},
+	},
+}
+
+var usersSearchMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"token": {}, "user_id": {}},
+	Table:    "users_search",
+	Columns: map[string]struct{}{
+		"token":   {},
+		"user_id": {},
+	},
+}
+
+/**
+ * Current search tokens of every user so that obsolete tokens can be removed on reindexing
+ */
+var usersSearchTokensMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Table:    "users_search_tokens",
+	Columns: map[string]struct{}{
+		"user_id": {},
+		"tokens":  {},
+	},
+}
+
+var privacyRulesMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}, "rule": {}},
+	Table:    "privacy_rules",
+	Columns: map[string]struct{}{
+		"user_id":     {},
+		"rule":        {},
+		"visibility":  {},
+		"allow_users": {},
+		"deny_users":  {},
+	},
+}
+
+var contactsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"owner_id": {}, "user_id": {}},
+	Table:    "contacts",
+	Columns: map[string]struct{}{
+		"owner_id": {},
+		"user_id":  {},
+		"phone":    {},
+		"name":     {},
+		"lastname": {},
+	},
+}
+
+/**
+ * Owners of the contact books that contain every user
+ */
+var contactsReverseMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}, "owner_id": {}},
+	Table:    "contacts_reverse",
+	Columns: map[string]struct{}{
+		"user_id":  {},
+		"owner_id": {},
+	},
+}
+
+var emailCodesMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"user_id": {}},
+	Table:    "email_codes",
+	Columns: map[string]struct{}{
+		"user_id":  {},
+		"code":     {},
+		"action":   {},
+		"attempts": {},
+	},
+}
+
+/**
+ * Phones that are imported into contacts of every owner. Rows are expired after window of the import rate limit
+ */
+var contactImportsMetadata = cassandraQB.TableMetadata{
+	Keyspace: "tg",
+	Pk:       map[string]struct{}{"owner_id": {}},
+	Ck:       map[string]struct{}{"phone": {}},
+	Table:    "contact_imports",
+	Columns: map[string]struct{}{
+		"owner_id":    {},
+		"phone":       {},
+		"imported_at": {},
 	},
 }
 
+/**
+ * Maximum number of values of an IN query. Larger lookups are split into several queries
+ */
+const maxInQuerySize = 100
+
+/**
+ * Maximum number of contacts that are written or deleted by a single batch
+ */
+const contactsBatchSize = 50
+
+/**
+ * Number of times that a conditional update is retried when another request has changed the row concurrently
+ */
+const maxCasRetries = 5
+
+/**
+ * Number of account activities that are fetched per page
+ */
+const accountActivitiesPageSize = 1000
+
 func NewUsersRepository(configs Configs, generator *uuid_generator.Generator) (Repository, error) {
 	connection := cassandraQB.Connection{
 		Cluster: gocql.NewCluster(configs.Hosts...),
@@ -113,144 +393,388 @@ func NewUsersRepository(configs Configs, generator *uuid_generator.Generator) (R
 	usersPkPhoneMetadata.Connection = connection.Session
 	usersPkUsernameMetadata.Connection = connection.Session
 	securityCodesMetaData.Connection = connection.Session
+	securityCodeRequestsMetadata.Connection = connection.Session
+	userCertificatesMetadata.Connection = connection.Session
+	revokedCertificatesMetadata.Connection = connection.Session
+	sessionsMetadata.Connection = connection.Session
+	accountDeletionsMetadata.Connection = connection.Session
+	accountActivitiesMetadata.Connection = connection.Session
+	passwordsMetadata.Connection = connection.Session
+	pendingLoginsMetadata.Connection = connection.Session
+	usersSearchMetadata.Connection = connection.Session
+	usersSearchTokensMetadata.Connection = connection.Session
+	privacyRulesMetadata.Connection = connection.Session
+	contactsMetadata.Connection = connection.Session
+	contactsReverseMetadata.Connection = connection.Session
+	emailCodesMetadata.Connection = connection.Session
+	contactImportsMetadata.Connection = connection.Session
 	return Repository{
-		connection:              connection,
-		usersMetadata:           usersMetadata,
-		usersPkPhoneMetadata:    usersPkPhoneMetadata,
-		usersPkUsernameMetadata: usersPkUsernameMetadata,
-		securityCodesMetaData:   securityCodesMetaData,
-		idGenerator:             generator,
-		consistencyLevels:       configs.ConsistencyLevels,
+		connection:                   connection,
+		usersMetadata:                usersMetadata,
+		usersPkPhoneMetadata:         usersPkPhoneMetadata,
+		usersPkUsernameMetadata:      usersPkUsernameMetadata,
+		securityCodesMetaData:        securityCodesMetaData,
+		securityCodeRequestsMetadata: securityCodeRequestsMetadata,
+		userCertificatesMetadata:     userCertificatesMetadata,
+		revokedCertificatesMetadata:  revokedCertificatesMetadata,
+		sessionsMetadata:             sessionsMetadata,
+		accountDeletionsMetadata:     accountDeletionsMetadata,
+		accountActivitiesMetadata:    accountActivitiesMetadata,
+		passwordsMetadata:            passwordsMetadata,
+		pendingLoginsMetadata:        pendingLoginsMetadata,
+		usersSearchMetadata:          usersSearchMetadata,
+		usersSearchTokensMetadata:    usersSearchTokensMetadata,
+		privacyRulesMetadata:         privacyRulesMetadata,
+		contactsMetadata:             contactsMetadata,
+		contactsReverseMetadata:      contactsReverseMetadata,
+		emailCodesMetadata:           emailCodesMetadata,
+		contactImportsMetadata:       contactImportsMetadata,
+		idGenerator:                  generator,
+		consistencyLevels:            configs.ConsistencyLevels,
 	}, nil
 }
 
-func (r Repository) NewUser(user domain.User) (err error) {
-	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+/**
+ * Claims the phone with a conditional insert into users_pk_phone so that concurrent signups of a phone never
+ * create two users. If the phone is already claimed false is returned otherwise id of the new user is returned.
+ * Users row is written only after a successful claim. If it fails the claim is deleted as compensation.
+ */
+func (r Repository) NewUser(user domain.User) (string, bool, error) {
 	id, err := r.idGenerator.GenerateV4()
 	switch err != nil {
 	case true:
 		reportError("generating uuid", err)
-		return errors2.InternalError{}
+		return "", false, errors2.InternalError{}
+	}
+	statement := r.connection.Session.Query("INSERT INTO "+r.usersPkPhoneMetadata.Table+" (phone, id, name, lastname, bio) VALUES (?, ?, ?, ?, ?) IF NOT EXISTS",
+		user.Phone, id.String(), user.Name, user.Lastname, user.Bio)
+	statement.SetConsistency(r.consistencyLevels.NewUser)
+	applied, err := statement.MapScanCAS(map[string]interface{}{})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return "", false, errors2.InternalError{}
+	}
+	switch applied {
+	case false:
+		return "", false, nil
 	}
-	data := map[string]interface{}{
+
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.usersMetadata.NewRecord(map[string]interface{}{
 		"id":       id.String(),
 		"name":     user.Name,
 		"lastname": user.Lastname,
+		"bio":      user.Bio,
 		"phone":    user.Phone,
+	}, batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		r.releasePhone(user.Phone, id.String(), r.consistencyLevels.NewUser)
+		return "", false, errors2.InternalError{}
 	}
-	err = r.usersMetadata.NewRecord(data, batch)
+	batch.SetConsistency(r.consistencyLevels.NewUser)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		r.releasePhone(user.Phone, id.String(), r.consistencyLevels.NewUser)
+		return "", false, errors2.InternalError{}
+	}
+	return id.String(), true, nil
+}
+
+/**
+ * Deletes the phone claim only if it is still owned by the user
+ */
+func (r Repository) releasePhone(phone string, id string, consistencyLevel gocql.Consistency) error {
+	statement := r.connection.Session.Query("DELETE FROM "+r.usersPkPhoneMetadata.Table+" WHERE phone = ? IF id = ?", phone, id)
+	statement.SetConsistency(consistencyLevel)
+	_, err := statement.MapScanCAS(map[string]interface{}{})
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
+	return nil
+}
 
-	err = r.usersPkPhoneMetadata.NewRecord(map[string]interface{}{
-		"id":       id.String(),
-		"name":     data["name"],
-		"phone":    data["phone"],
-		"lastname": data["lastname"],
-		"bio":      data["bio"],
-		"username": data["username"],
-	}, batch)
+/**
+ * Claims the new phone with a conditional insert of a copy of the users_pk_phone row of the user. If the phone is
+ * owned by another user false is returned. After the claim, phone of the user is updated in users table and then
+ * the old phone row is deleted. If the update fails the claim is rolled back.
+ */
+func (r Repository) ChangePhone(user domain.User, phone string) (bool, error) {
+	previous := map[string]interface{}{}
+	statement := r.connection.Session.Query("INSERT INTO "+r.usersPkPhoneMetadata.Table+" (phone, id, name, lastname, bio, username) VALUES (?, ?, ?, ?, ?, ?) IF NOT EXISTS",
+		phone, user.Id, user.Name, user.Lastname, user.Bio, user.Username)
+	statement.SetConsistency(r.consistencyLevels.ChangePhone)
+	applied, err := statement.MapScanCAS(previous)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	owner, _ := previous["id"].(gocql.UUID)
+	/**
+	 * A row of the user itself is left from a failed change and can be reused
+	 */
+	switch !applied && owner.String() != user.Id {
+	case true:
+		return false, nil
+	}
 
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.usersMetadata.UpdateRecord(map[string]interface{}{"id": user.Id}, map[string]interface{}{"phone": phone}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
-		return errors2.InternalError{}
+		r.releasePhone(phone, user.Id, r.consistencyLevels.ChangePhone)
+		return false, errors2.InternalError{}
 	}
-	batch.SetConsistency(r.consistencyLevels.NewUser)
+	batch.SetConsistency(r.consistencyLevels.ChangePhone)
 	err = r.connection.Session.ExecuteBatch(batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
-		return errors2.InternalError{}
+		r.releasePhone(phone, user.Id, r.consistencyLevels.ChangePhone)
+		return false, errors2.InternalError{}
 	}
-	return
+	err = r.releasePhone(user.Phone, user.Id, r.consistencyLevels.ChangePhone)
+	switch err != nil {
+	case true:
+		return false, err
+	}
+	return true, nil
 }
 
-func (r Repository) UpdateUsername(phone string, username string) (err error) {
-	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+/**
+ * Claims username for the user with a lightweight transaction so that concurrent claims of a username never
+ * succeed together. If the username is owned by another user false is returned.
+ * After the claim, username is updated in users table and then in users_pk_phone row of the user only while the row
+ * is owned by the user. Then the old username mapping is released. If updating users table fails the claim is
+ * rolled back. A failure after that leaves the claim so that a retry of the request completes the change.
+ */
+func (r Repository) UpdateUsername(phone string, username string) (bool, error) {
 	user, err := r.getUserByPhone(phone)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return false, errors2.EntityNotFound{}
+		}
+		return false, errors2.InternalError{}
+	}
+	switch user.Username == username {
+	case true:
+		return true, nil
+	}
 
+	previous := map[string]interface{}{}
+	statement := r.connection.Session.Query("INSERT INTO "+r.usersPkUsernameMetadata.Table+" (username, id) VALUES (?, ?) IF NOT EXISTS", username, user.Id)
+	statement.SetConsistency(r.consistencyLevels.UpdateUsername)
+	applied, err := statement.MapScanCAS(previous)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	owner, _ := previous["id"].(gocql.UUID)
 	/**
-	 * In this case gocql.ErrNotFound is not needed to be checked because the username
-	 * existence is checked before in core
+	 * A mapping to the user itself is left from a failed claim and can be reused
 	 */
+	switch !applied && owner.String() != user.Id {
+	case true:
+		return false, nil
+	}
+
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.usersMetadata.UpdateRecord(map[string]interface{}{"id": user.Id}, map[string]interface{}{"username": username}, batch)
 	switch err != nil {
 	case true:
-		return errors2.InternalError{}
+		reportQueryError(err)
+		r.releaseUsername(username, user.Id, r.consistencyLevels.UpdateUsername)
+		return false, errors2.InternalError{}
+	}
+	batch.SetConsistency(r.consistencyLevels.UpdateUsername)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		r.releaseUsername(username, user.Id, r.consistencyLevels.UpdateUsername)
+		return false, errors2.InternalError{}
+	}
+	err = r.updatePhoneRow(user, map[string]interface{}{"username": username}, r.consistencyLevels.UpdateUsername)
+	switch err != nil {
+	case true:
+		return false, err
+	}
+
+	/**
+	 * Username is already changed at this point. Failure of releasing the old username is only reported
+	 */
+	switch user.Username != "" {
+	case true:
+		r.releaseUsername(user.Username, user.Id, r.consistencyLevels.UpdateUsername)
 	}
+	return true, nil
+}
 
-	err = r.usersPkPhoneMetadata.UpdateRecord(map[string]interface{}{"phone": phone}, map[string]interface{}{"username": username}, batch)
+/**
+ * Deletes the username mapping only if it is still owned by the user
+ */
+func (r Repository) releaseUsername(username string, id string, consistencyLevel gocql.Consistency) error {
+	statement := r.connection.Session.Query("DELETE FROM "+r.usersPkUsernameMetadata.Table+" WHERE username = ? IF id = ?", username, id)
+	statement.SetConsistency(consistencyLevel)
+	_, err := statement.MapScanCAS(map[string]interface{}{})
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
+	return nil
+}
 
-	err = r.usersMetadata.UpdateRecord(map[string]interface{}{"id": user.Id}, map[string]interface{}{"username": username}, batch)
+/**
+ * Updates users table and then users_pk_phone row of the user. Field names are the same as column names
+ */
+func (r Repository) UpdateProfile(user domain.User, fields []string) (err error) {
+	values := map[string]interface{}{
+		"name":     user.Name,
+		"lastname": user.Lastname,
+		"bio":      user.Bio,
+	}
+	data := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		data[field] = values[field]
+	}
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.usersMetadata.UpdateRecord(map[string]interface{}{"id": user.Id}, data, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
-
-	switch user.Username != "" {
+	batch.SetConsistency(r.consistencyLevels.UpdateProfile)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
 	case true:
-		err = r.usersPkUsernameMetadata.DeleteRecord(map[string]interface{}{"username": user.Username}, batch)
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return r.updatePhoneRow(user, data, r.consistencyLevels.UpdateProfile)
+}
+
+/**
+ * Updates users_pk_phone row of the user with a conditional update so that a row which is released by a concurrent
+ * phone change or deletion is never recreated as a partial row. If phone of the user is changed meanwhile,
+ * the row of the current phone is updated instead. Columns of the row are keys of data.
+ */
+func (r Repository) updatePhoneRow(user domain.User, data map[string]interface{}, consistencyLevel gocql.Consistency) error {
+	columns := make([]string, 0, len(data))
+	for column := range data {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	assignments := make([]string, 0, len(columns))
+	values := make([]interface{}, 0, len(columns)+2)
+	for _, column := range columns {
+		assignments = append(assignments, column+" = ?")
+		values = append(values, data[column])
+	}
+	query := "UPDATE " + r.usersPkPhoneMetadata.Table + " SET " + strings.Join(assignments, ", ") + " WHERE phone = ? IF id = ?"
+	phone := user.Phone
+	for attempt := 0; attempt < maxCasRetries; attempt++ {
+		statement := r.connection.Session.Query(query, append(values, phone, user.Id)...)
+		statement.SetConsistency(consistencyLevel)
+		applied, err := statement.MapScanCAS(map[string]interface{}{})
 		switch err != nil {
 		case true:
 			reportQueryError(err)
 			return errors2.InternalError{}
 		}
+		switch applied {
+		case true:
+			return nil
+		}
+		current, err := r.getUserById(user.Id, consistencyLevel)
+		switch {
+		case errors.As(err, &errors2.EntityNotFound{}):
+			return nil
+		case err != nil:
+			return err
+		case current.Phone == phone:
+			return nil
+		}
+		phone = current.Phone
 	}
-	batch.SetConsistency(r.consistencyLevels.UpdateUsername)
-	err = r.connection.Session.ExecuteBatch(batch)
+	return nil
+}
+
+/**
+ * Deletes the user. Phone and username mappings are deleted with conditional deletes only if they are still owned
+ * by the user so that mappings which are claimed by other users meanwhile are kept. Other rows of the user are
+ * deleted afterwards in a logged batch. All deletes are idempotent so a failed deletion can be retried.
+ */
+func (r Repository) DeleteUser(user domain.User) (err error) {
+	err = r.releasePhone(user.Phone, user.Id, r.consistencyLevels.DeleteUser)
+	switch err != nil {
+	case true:
+		return err
+	}
+	switch user.Username != "" {
+	case true:
+		err = r.releaseUsername(user.Username, user.Id, r.consistencyLevels.DeleteUser)
+		switch err != nil {
+		case true:
+			return err
+		}
+	}
+
+	batch := r.connection.Session.NewBatch(gocql.LoggedBatch)
+	err = r.usersMetadata.DeleteRecord(map[string]interface{}{"id": user.Id}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
 
-	batch = r.connection.Session.NewBatch(gocql.UnloggedBatch)
-	err = r.usersPkUsernameMetadata.NewRecord(map[string]interface{}{"username": username, "id": user.Id}, batch)
+	err = r.securityCodesMetaData.DeleteRecord(map[string]interface{}{"phone": user.Phone}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
 
-	batch.SetConsistency(r.consistencyLevels.UpdateUsername)
-	err = r.connection.Session.ExecuteBatch(batch)
+	err = r.sessionsMetadata.DeleteRecord(map[string]interface{}{"user_id": user.Id}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
-	return
-}
 
-func (r Repository) DeleteUser(phone string) (err error) {
-	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
-	user, err := r.getUserByPhone(phone)
+	err = r.accountActivitiesMetadata.DeleteRecord(map[string]interface{}{"user_id": user.Id}, batch)
 	switch err != nil {
 	case true:
+		reportQueryError(err)
 		return errors2.InternalError{}
 	}
-	err = r.usersPkPhoneMetadata.DeleteRecord(map[string]interface{}{"phone": phone}, batch)
+
+	err = r.passwordsMetadata.DeleteRecord(map[string]interface{}{"user_id": user.Id}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
 
-	err = r.usersMetadata.DeleteRecord(map[string]interface{}{"id": user.Id}, batch)
+	err = r.emailCodesMetadata.DeleteRecord(map[string]interface{}{"user_id": user.Id}, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
 		return errors2.InternalError{}
 	}
+	batch.Query("DELETE FROM "+r.privacyRulesMetadata.Table+" WHERE user_id = ?", user.Id)
 	batch.SetConsistency(r.consistencyLevels.DeleteUser)
 	err = r.connection.Session.ExecuteBatch(batch)
 	switch err != nil {
@@ -325,13 +849,26 @@ func (r Repository) getUserByUsername(username string) (domain.User, error) {
 	case true:
 		return domain.User{}, errors2.InternalError{}
 	}
-	statement, err := r.usersMetadata.GetSelectStatement(map[string]interface{}{"id": id}, []string{"*"})
+	return r.getUserById(id, r.consistencyLevels.GetUserByUsername)
+}
+
+func (r Repository) GetUserById(id string) (domain.User, error) {
+	return r.getUserById(id, r.consistencyLevels.GetUserById)
+}
+
+func (r Repository) getUserById(id string, consistencyLevel gocql.Consistency) (domain.User, error) {
+	uuid, err := gocql.ParseUUID(id)
 	switch err != nil {
 	case true:
-		reportQueryError(err)
+		return domain.User{}, errors2.EntityNotFound{}
+	}
+	statement, err := r.usersMetadata.GetSelectStatement(map[string]interface{}{"id": uuid}, []string{"*"})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
 		return domain.User{}, errors2.InternalError{}
 	}
-	statement.SetConsistency(r.consistencyLevels.GetUserByUsername)
+	statement.SetConsistency(consistencyLevel)
 	user, err := r.usersMetadata.FetchFromSelectStatement(statement)
 	switch err != nil {
 	case true:
@@ -342,18 +879,65 @@ func (r Repository) getUserByUsername(username string) (domain.User, error) {
 		reportQueryError(err)
 		return domain.User{}, errors2.InternalError{}
 	}
+	return newUserFromRow(user), nil
+}
+
+/**
+ * Fetches users with given ids by a single IN query. Users that do not exist and ids that are not valid uuids
+ * are not included in the returned map which is keyed by user id
+ */
+func (r Repository) GetUsersByIds(ids []string) (map[string]domain.User, error) {
+	users := make(map[string]domain.User, len(ids))
+	uuids := make([]gocql.UUID, 0, len(ids))
+	for _, id := range ids {
+		uuid, err := gocql.ParseUUID(id)
+		switch err != nil {
+		case true:
+			continue
+		}
+		uuids = append(uuids, uuid)
+	}
+	switch len(uuids) == 0 {
+	case true:
+		return users, nil
+	}
+	statement := r.connection.Session.Query("SELECT * FROM "+r.usersMetadata.Table+" WHERE id IN ?", uuids)
+	statement.SetConsistency(r.consistencyLevels.GetUsersByIds)
+	iterator := statement.Iter()
+	for {
+		row := map[string]interface{}{}
+		switch iterator.MapScan(row) {
+		case false:
+			err := iterator.Close()
+			switch err != nil {
+			case true:
+				reportQueryError(err)
+				return nil, errors2.InternalError{}
+			}
+			return users, nil
+		}
+		user := newUserFromRow(row)
+		users[user.Id] = user
+	}
+}
+
+func newUserFromRow(user map[string]interface{}) domain.User {
 	return domain.User{
 		Id:            user["id"].(gocql.UUID).String(),
 		Name:          user["name"].(string),
 		Lastname:      user["lastname"].(string),
 		Bio:           user["bio"].(string),
-		Username:      username,
+		Username:      user["username"].(string),
 		Phone:         user["phone"].(string),
 		Online_status: user["online_status"].(bool),
+		Last_seen:     user["last_seen"].(time.Time),
 		Created_at:    user["created_at"].(time.Time),
-	}, nil
+	}
 }
 
+/**
+ * Users_pk_phone only contains the profile of the user so the user is read from users table by its id
+ */
 func (r Repository) GetUserByPhone(phone string) (domain.User, error) {
 	user, err := r.getUserByPhone(phone)
 	switch err != nil {
@@ -364,7 +948,7 @@ func (r Repository) GetUserByPhone(phone string) (domain.User, error) {
 		}
 		return domain.User{}, errors2.InternalError{}
 	}
-	return user, nil
+	return r.getUserById(user.Id, r.consistencyLevels.GetUserByPhone)
 }
 
 func (r Repository) getUserByPhone(phone string) (domain.User, error) {
@@ -389,23 +973,103 @@ func (r Repository) getUserByPhone(phone string) (domain.User, error) {
 		Id:       user["id"].(gocql.UUID).String(),
 		Name:     user["name"].(string),
 		Lastname: user["lastname"].(string),
-		Bio:      user["lastname"].(string),
+		Bio:      user["bio"].(string),
 		Username: user["username"].(string),
 		Phone:    phone,
 	}, nil
 }
 
-func (r Repository) RecordSecurityCode(securityCode domain.SecurityCode) (err error) {
+func (r Repository) RecordSecurityCode(securityCode domain.SecurityCode) error {
+	return r.recordCode(r.securityCodesMetaData, map[string]interface{}{
+		"phone":   securityCode.Phone,
+		"user_id": securityCode.UserId,
+	}, securityCode)
+}
+
+func (r Repository) GetSecurityCode(phone string) (domain.SecurityCode, error) {
+	row, err := r.getCode(r.securityCodesMetaData, "phone", phone, "user_id")
+	switch err != nil {
+	case true:
+		return domain.SecurityCode{}, err
+	}
+	securityCode := parseSecurityCode(row)
+	securityCode.Phone = phone
+	// User id is null for security codes that are not bound to a user
+	securityCode.UserId, _ = row["user_id"].(string)
+	return securityCode, nil
+}
+
+/**
+ * Increments failed attempts of the security code using a lightweight transaction so that
+ * concurrent verifications can not be lost. New number of attempts will be returned.
+ * If the security code does not exist EntityNotFound error will be returned.
+ */
+func (r Repository) IncrementSecurityCodeAttempts(phone string) (int, error) {
+	return r.incrementCodeAttempts(r.securityCodesMetaData, "phone", phone)
+}
+
+func (r Repository) DeleteSecurityCode(phone string) error {
+	return r.deleteCode(r.securityCodesMetaData, "phone", phone)
+}
+
+/**
+ * Deletes the security code with a conditional delete only if it is still the given hashed code so that a
+ * security code is consumed at most once by concurrent requests. If the code is already consumed or replaced
+ * false is returned.
+ */
+func (r Repository) ConsumeSecurityCode(phone string, code string) (bool, error) {
+	return r.consumeCode(r.securityCodesMetaData, "phone", phone, code)
+}
+
+/**
+ * Email security codes are stored in email_codes table keyed by user id. They use consistency levels of
+ * phone security codes.
+ */
+func (r Repository) RecordEmailCode(securityCode domain.SecurityCode) error {
+	return r.recordCode(r.emailCodesMetadata, map[string]interface{}{"user_id": securityCode.UserId}, securityCode)
+}
+
+func (r Repository) GetEmailCode(userId string) (domain.SecurityCode, error) {
+	row, err := r.getCode(r.emailCodesMetadata, "user_id", userId)
+	switch err != nil {
+	case true:
+		return domain.SecurityCode{}, err
+	}
+	securityCode := parseSecurityCode(row)
+	securityCode.UserId = userId
+	return securityCode, nil
+}
+
+func (r Repository) IncrementEmailCodeAttempts(userId string) (int, error) {
+	return r.incrementCodeAttempts(r.emailCodesMetadata, "user_id", userId)
+}
+
+func (r Repository) DeleteEmailCode(userId string) error {
+	return r.deleteCode(r.emailCodesMetadata, "user_id", userId)
+}
+
+func (r Repository) ConsumeEmailCode(userId string, code string) (bool, error) {
+	return r.consumeCode(r.emailCodesMetadata, "user_id", userId, code)
+}
+
+/**
+ * Writes a security code with zero attempts. Keys are the key columns of the table.
+ */
+func (r Repository) recordCode(metadata cassandraQB.TableMetadata, keys map[string]interface{}, securityCode domain.SecurityCode) (err error) {
+	values := map[string]interface{}{
+		"code":     securityCode.SecurityCode,
+		"action":   securityCode.Action,
+		"attempts": 0,
+	}
+	for column, value := range keys {
+		values[column] = value
+	}
 	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
-	err = r.securityCodesMetaData.NewRecord(map[string]interface{}{
-		"phone":  securityCode.Phone,
-		"code":   securityCode.SecurityCode,
-		"action": securityCode.Action,
-	}, batch)
+	err = metadata.NewRecord(values, batch)
 	switch err != nil {
 	case true:
 		reportQueryError(err)
-		return
+		return errors2.InternalError{}
 	}
 
 	batch.SetConsistency(r.consistencyLevels.RecordSecurityCode)
@@ -418,43 +1082,987 @@ func (r Repository) RecordSecurityCode(securityCode domain.SecurityCode) (err er
 	return nil
 }
 
-func (r Repository) GetSecurityCode(phone string) (domain.SecurityCode, error) {
-	statement, err := r.securityCodesMetaData.GetSelectStatement(map[string]interface{}{"phone": phone}, []string{"phone", "code", "writetime(code) as created_at", "action"})
+/**
+ * Fetches the security code row of the key with the given extra columns. If there is no security code
+ * EntityNotFound error will be returned.
+ */
+func (r Repository) getCode(metadata cassandraQB.TableMetadata, keyColumn string, key string, columns ...string) (map[string]interface{}, error) {
+	statement, err := metadata.GetSelectStatement(map[string]interface{}{keyColumn: key}, append([]string{"code", "writetime(code) as created_at", "action", "attempts"}, columns...))
 	switch err != nil {
 	case true:
 		reportQueryError(err)
-		return domain.SecurityCode{}, errors2.InternalError{}
+		return nil, errors2.InternalError{}
 	}
 	statement.SetConsistency(r.consistencyLevels.GetSecurityCode)
-	securityCode, err := r.securityCodesMetaData.FetchFromSelectStatement(statement)
+	row, err := metadata.FetchFromSelectStatement(statement)
 	switch err != nil {
 	case true:
 		switch errors.Is(err, gocql.ErrNotFound) {
 		case true:
-			return domain.SecurityCode{}, errors2.EntityNotFound{}
+			return nil, errors2.EntityNotFound{}
 		}
 		reportQueryError(err)
-		return domain.SecurityCode{}, errors2.InternalError{}
+		return nil, errors2.InternalError{}
+	}
+	/**
+	 * Attempts column may outlive the code itself because it is written after the code.
+	 * In this case the security code is already expired.
+	 */
+	switch row["code"].(string) == "" {
+	case true:
+		return nil, errors2.EntityNotFound{}
 	}
+	return row, nil
+}
+
+func parseSecurityCode(row map[string]interface{}) domain.SecurityCode {
 	return domain.SecurityCode{
-		Phone:        securityCode["phone"].(string),
-		SecurityCode: securityCode["code"].(string),
-		Action:       securityCode["action"].(string),
-		CreatedAt:    parseMicroSeconds(securityCode["created_at"].(int64)),
-	}, nil
+		SecurityCode: row["code"].(string),
+		Action:       row["action"].(string),
+		Attempts:     row["attempts"].(int),
+		CreatedAt:    parseMicroSeconds(row["created_at"].(int64)),
+	}
+}
+
+func (r Repository) incrementCodeAttempts(metadata cassandraQB.TableMetadata, keyColumn string, key string) (int, error) {
+	attempts := 0
+	for i := 0; i < maxCasRetries; i++ {
+		previous := map[string]interface{}{}
+		statement := r.connection.Session.Query("UPDATE "+metadata.Table+" SET attempts = ? WHERE "+keyColumn+" = ? IF code != null AND attempts = ?", attempts+1, key, attempts)
+		statement.SetConsistency(r.consistencyLevels.IncrementSecurityCodeAttempts)
+		applied, err := statement.MapScanCAS(previous)
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return 0, errors2.InternalError{}
+		}
+		switch applied {
+		case true:
+			return attempts + 1, nil
+		}
+		code, _ := previous["code"].(string)
+		switch code == "" {
+		case true:
+			return 0, errors2.EntityNotFound{}
+		}
+		attempts, _ = previous["attempts"].(int)
+	}
+	reportError("incrementing security code attempts", errors.New("maximum retries of conditional update reached for "+keyColumn+" "+key))
+	return 0, errors2.InternalError{}
+}
+
+func (r Repository) deleteCode(metadata cassandraQB.TableMetadata, keyColumn string, key string) (err error) {
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = metadata.DeleteRecord(map[string]interface{}{keyColumn: key}, batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	batch.SetConsistency(r.consistencyLevels.DeleteSecurityCode)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return
+}
+
+func (r Repository) consumeCode(metadata cassandraQB.TableMetadata, keyColumn string, key string, code string) (bool, error) {
+	statement := r.connection.Session.Query("DELETE FROM "+metadata.Table+" WHERE "+keyColumn+" = ? IF code = ?", key, code)
+	statement.SetConsistency(r.consistencyLevels.ConsumeSecurityCode)
+	applied, err := statement.MapScanCAS(map[string]interface{}{})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	return applied, nil
 }
 
 /**
- * Reports errors to central error recorder
+ * Records a security code request under given rate limit key. Record is expired after ttl.
  */
-func reportError(subject string, err error) {
-	errorReporter.ReportError("An error occurred while %s. Error message: %s", subject, err.Error())
+func (r Repository) RecordSecurityCodeRequest(key string, requestedAt time.Time, ttl time.Duration) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.securityCodeRequestsMetadata.Table+" (key, requested_at) VALUES (?, ?) USING TTL ?", key, requestedAt, int(ttl.Seconds()))
+	statement.SetConsistency(r.consistencyLevels.RecordSecurityCodeRequest)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
 }
 
-func reportQueryError(err error) {
-	reportError("executing a query", err)
+/**
+ * Returns time of the security code requests of given rate limit key that are recorded after since
+ */
+func (r Repository) GetSecurityCodeRequests(key string, since time.Time) ([]time.Time, error) {
+	statement := r.connection.Session.Query("SELECT requested_at FROM "+r.securityCodeRequestsMetadata.Table+" WHERE key = ? AND requested_at > ?", key, since)
+	statement.SetConsistency(r.consistencyLevels.GetSecurityCodeRequests)
+	iterator := statement.Iter()
+	requests := make([]time.Time, 0)
+	var requestedAt time.Time
+	for iterator.Scan(&requestedAt) {
+		requests = append(requests, requestedAt)
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return requests, nil
+}
+
+/**
+ * Records an issued certificate. Record is expired when the certificate expires.
+ */
+func (r Repository) RecordCertificate(certificate domain.Certificate) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.userCertificatesMetadata.Table+" (user_id, serial, issued_at, expires_at) VALUES (?, ?, ?, ?) USING TTL ?",
+		certificate.UserId, certificate.Serial, certificate.IssuedAt, certificate.ExpiresAt, ttlUntil(certificate.ExpiresAt))
+	statement.SetConsistency(r.consistencyLevels.RecordCertificate)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns all unexpired certificates of the user including the revoked ones
+ */
+func (r Repository) GetUserCertificates(userId string) ([]domain.Certificate, error) {
+	statement := r.connection.Session.Query("SELECT serial, issued_at, expires_at, revoked_at FROM "+r.userCertificatesMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetUserCertificates)
+	iterator := statement.Iter()
+	certificates := make([]domain.Certificate, 0)
+	certificate := domain.Certificate{UserId: userId}
+	for iterator.Scan(&certificate.Serial, &certificate.IssuedAt, &certificate.ExpiresAt, &certificate.RevokedAt) {
+		certificates = append(certificates, certificate)
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return certificates, nil
+}
+
+/**
+ * Marks certificates as revoked and adds them to revocation list.
+ * Revocation list entries are expired with the certificates since expired certificates are rejected anyway.
+ */
+func (r Repository) RevokeCertificates(certificates []domain.Certificate) error {
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	for _, certificate := range certificates {
+		ttl := ttlUntil(certificate.ExpiresAt)
+		batch.Query("UPDATE "+r.userCertificatesMetadata.Table+" USING TTL ? SET revoked_at = ? WHERE user_id = ? AND serial = ?",
+			ttl, certificate.RevokedAt, certificate.UserId, certificate.Serial)
+		batch.Query("INSERT INTO "+r.revokedCertificatesMetadata.Table+" (serial, user_id, revoked_at, expires_at) VALUES (?, ?, ?, ?) USING TTL ?",
+			certificate.Serial, certificate.UserId, certificate.RevokedAt, certificate.ExpiresAt, ttl)
+	}
+	batch.SetConsistency(r.consistencyLevels.RevokeCertificates)
+	err := r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) IsCertificateRevoked(serial string) (bool, error) {
+	statement, err := r.revokedCertificatesMetadata.GetSelectStatement(map[string]interface{}{"serial": serial}, []string{"serial"})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	statement.SetConsistency(r.consistencyLevels.IsCertificateRevoked)
+	_, err = r.revokedCertificatesMetadata.FetchFromSelectStatement(statement)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return false, nil
+		}
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	return true, nil
+}
+
+/**
+ * Returns all revoked certificates that are not expired yet.
+ * Revoked certificates table only holds unexpired certificates so a full scan is cheap.
+ */
+func (r Repository) GetRevokedCertificates() ([]domain.Certificate, error) {
+	statement := r.connection.Session.Query("SELECT serial, user_id, revoked_at, expires_at FROM " + r.revokedCertificatesMetadata.Table)
+	statement.SetConsistency(r.consistencyLevels.GetRevokedCertificates)
+	iterator := statement.Iter()
+	certificates := make([]domain.Certificate, 0)
+	certificate := domain.Certificate{}
+	var userId gocql.UUID
+	for iterator.Scan(&certificate.Serial, &userId, &certificate.RevokedAt, &certificate.ExpiresAt) {
+		certificate.UserId = userId.String()
+		certificates = append(certificates, certificate)
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return certificates, nil
+}
+
+/**
+ * Records a new session. Session is expired with its certificate.
+ */
+func (r Repository) NewSession(session domain.Session) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.sessionsMetadata.Table+" (user_id, serial, device, ip, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?) USING TTL ?",
+		session.UserId, session.Serial, session.Device, session.Ip, session.CreatedAt, session.ExpiresAt, ttlUntil(session.ExpiresAt))
+	statement.SetConsistency(r.consistencyLevels.NewSession)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) GetUserSessions(userId string) ([]domain.Session, error) {
+	statement := r.connection.Session.Query("SELECT serial, device, ip, created_at, expires_at FROM "+r.sessionsMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetUserSessions)
+	iterator := statement.Iter()
+	sessions := make([]domain.Session, 0)
+	session := domain.Session{UserId: userId}
+	for iterator.Scan(&session.Serial, &session.Device, &session.Ip, &session.CreatedAt, &session.ExpiresAt) {
+		sessions = append(sessions, session)
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return sessions, nil
+}
+
+func (r Repository) DeleteSessions(userId string, serials []string) (err error) {
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	for _, serial := range serials {
+		err = r.sessionsMetadata.DeleteRecord(map[string]interface{}{"user_id": userId, "serial": serial}, batch)
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return errors2.InternalError{}
+		}
+	}
+	batch.SetConsistency(r.consistencyLevels.DeleteSessions)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return
+}
+
+func (r Repository) ScheduleAccountDeletion(deletion domain.AccountDeletion) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.accountDeletionsMetadata.Table+" (user_id, scheduled_at, delete_at) VALUES (?, ?, ?)",
+		deletion.UserId, deletion.ScheduledAt, deletion.DeleteAt)
+	statement.SetConsistency(r.consistencyLevels.ScheduleAccountDeletion)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) GetAccountDeletion(userId string) (domain.AccountDeletion, error) {
+	statement := r.connection.Session.Query("SELECT scheduled_at, delete_at FROM "+r.accountDeletionsMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetAccountDeletion)
+	deletion := domain.AccountDeletion{
+		UserId: userId,
+	}
+	err := statement.Scan(&deletion.ScheduledAt, &deletion.DeleteAt)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return domain.AccountDeletion{}, errors2.EntityNotFound{}
+		}
+		reportQueryError(err)
+		return domain.AccountDeletion{}, errors2.InternalError{}
+	}
+	return deletion, nil
+}
+
+func (r Repository) CancelAccountDeletion(userId string) (err error) {
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.accountDeletionsMetadata.DeleteRecord(map[string]interface{}{"user_id": userId}, batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	batch.SetConsistency(r.consistencyLevels.CancelAccountDeletion)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return
+}
+
+/**
+ * Scans all scheduled deletions and returns the ones that are due until given time.
+ * Number of scheduled deletions is small because they are deleted after the grace period
+ */
+func (r Repository) GetDueAccountDeletions(until time.Time) ([]domain.AccountDeletion, error) {
+	statement := r.connection.Session.Query("SELECT user_id, scheduled_at, delete_at FROM " + r.accountDeletionsMetadata.Table)
+	statement.SetConsistency(r.consistencyLevels.GetDueAccountDeletions)
+	iterator := statement.Iter()
+	deletions := make([]domain.AccountDeletion, 0)
+	deletion := domain.AccountDeletion{}
+	var userId gocql.UUID
+	for iterator.Scan(&userId, &deletion.ScheduledAt, &deletion.DeleteAt) {
+		switch deletion.DeleteAt.After(until) {
+		case false:
+			deletion.UserId = userId.String()
+			deletions = append(deletions, deletion)
+		}
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return deletions, nil
+}
+
+func (r Repository) RecordAccountActivity(userId string, at time.Time) error {
+	statement := r.connection.Session.Query("UPDATE "+r.accountActivitiesMetadata.Table+" SET last_activity = ? WHERE user_id = ?", at, userId)
+	statement.SetConsistency(r.consistencyLevels.RecordAccountActivity)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) SetAccountTTL(userId string, ttlDays int) error {
+	statement := r.connection.Session.Query("UPDATE "+r.accountActivitiesMetadata.Table+" SET ttl_days = ? WHERE user_id = ?", ttlDays, userId)
+	statement.SetConsistency(r.consistencyLevels.SetAccountTTL)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Returns zero if the user has not set the ttl
+ */
+func (r Repository) GetAccountTTL(userId string) (int, error) {
+	statement := r.connection.Session.Query("SELECT ttl_days FROM "+r.accountActivitiesMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetAccountTTL)
+	ttlDays := 0
+	err := statement.Scan(&ttlDays)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return 0, nil
+		}
+		reportQueryError(err)
+		return 0, errors2.InternalError{}
+	}
+	return ttlDays, nil
+}
+
+/**
+ * Returns one page of activities of all users and paging state of the next page so that callers never hold
+ * activities of all users in memory. Empty returned page state means that the scan is finished.
+ */
+func (r Repository) GetAccountActivities(pageState []byte) ([]domain.AccountActivity, []byte, error) {
+	statement := r.connection.Session.Query("SELECT user_id, last_activity, ttl_days FROM " + r.accountActivitiesMetadata.Table)
+	statement.SetConsistency(r.consistencyLevels.GetAccountActivities)
+	// Setting page state disables automatic paging so only one page is fetched
+	iterator := statement.PageSize(accountActivitiesPageSize).PageState(pageState).Iter()
+	nextPageState := iterator.PageState()
+	activities := make([]domain.AccountActivity, 0, iterator.NumRows())
+	activity := domain.AccountActivity{}
+	var userId gocql.UUID
+	for iterator.Scan(&userId, &activity.LastActivity, &activity.TTLDays) {
+		activity.UserId = userId.String()
+		activities = append(activities, activity)
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, nil, errors2.InternalError{}
+	}
+	return activities, nextPageState, nil
+}
+
+/**
+ * Deletes activity and inactivity period of the user
+ */
+func (r Repository) DeleteAccountActivity(userId string) error {
+	statement := r.connection.Session.Query("DELETE FROM "+r.accountActivitiesMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.DeleteAccountActivity)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) SetPassword(password domain.Password) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.passwordsMetadata.Table+" (user_id, password_hash, hint, recovery_email, recovery_email_verified, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		password.UserId, password.Hash, password.Hint, password.RecoveryEmail, password.RecoveryEmailVerified, password.UpdatedAt)
+	statement.SetConsistency(r.consistencyLevels.SetPassword)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) GetPassword(userId string) (domain.Password, error) {
+	statement := r.connection.Session.Query("SELECT password_hash, hint, recovery_email, recovery_email_verified, updated_at FROM "+r.passwordsMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetPassword)
+	password := domain.Password{
+		UserId: userId,
+	}
+	err := statement.Scan(&password.Hash, &password.Hint, &password.RecoveryEmail, &password.RecoveryEmailVerified, &password.UpdatedAt)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return domain.Password{}, errors2.EntityNotFound{}
+		}
+		reportQueryError(err)
+		return domain.Password{}, errors2.InternalError{}
+	}
+	return password, nil
+}
+
+func (r Repository) DeletePassword(userId string) (err error) {
+	batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+	err = r.passwordsMetadata.DeleteRecord(map[string]interface{}{"user_id": userId}, batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	batch.SetConsistency(r.consistencyLevels.DeletePassword)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return
+}
+
+/**
+ * Pending logins are expired by cassandra after ttl
+ */
+func (r Repository) RecordPendingLogin(login domain.PendingLogin, ttl time.Duration) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.pendingLoginsMetadata.Table+" (token_hash, user_id, public_key, device, ip, created_at) VALUES (?, ?, ?, ?, ?, ?) USING TTL ?",
+		login.TokenHash, login.UserId, login.PublicKey, login.Device, login.Ip, login.CreatedAt, int(ttl.Seconds()))
+	statement.SetConsistency(r.consistencyLevels.RecordPendingLogin)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+func (r Repository) GetPendingLogin(tokenHash string) (domain.PendingLogin, error) {
+	statement := r.connection.Session.Query("SELECT user_id, public_key, device, ip, created_at FROM "+r.pendingLoginsMetadata.Table+" WHERE token_hash = ?", tokenHash)
+	statement.SetConsistency(r.consistencyLevels.GetPendingLogin)
+	login := domain.PendingLogin{
+		TokenHash: tokenHash,
+	}
+	var userId gocql.UUID
+	err := statement.Scan(&userId, &login.PublicKey, &login.Device, &login.Ip, &login.CreatedAt)
+	switch err != nil {
+	case true:
+		switch errors.Is(err, gocql.ErrNotFound) {
+		case true:
+			return domain.PendingLogin{}, errors2.EntityNotFound{}
+		}
+		reportQueryError(err)
+		return domain.PendingLogin{}, errors2.InternalError{}
+	}
+	login.UserId = userId.String()
+	return login, nil
+}
+
+/**
+ * Deletes the pending login with a lightweight transaction so that only one of concurrent deletions of a
+ * pending login is applied. If the pending login does not exist anymore false is returned.
+ */
+func (r Repository) DeletePendingLogin(tokenHash string) (bool, error) {
+	statement := r.connection.Session.Query("DELETE FROM "+r.pendingLoginsMetadata.Table+" WHERE token_hash = ? IF EXISTS", tokenHash)
+	statement.SetConsistency(r.consistencyLevels.DeletePendingLogin)
+	applied, err := statement.MapScanCAS(map[string]interface{}{})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	return applied, nil
+}
+
+/**
+ * Replaces search tokens of the user. Only the difference of current and new tokens is written
+ * by a logged batch so that the index and stored tokens of the user do not diverge.
+ */
+func (r Repository) IndexUser(userId string, tokens []string) error {
+	uuid, err := gocql.ParseUUID(userId)
+	switch err != nil {
+	case true:
+		return errors2.InternalError{}
+	}
+	statement := r.connection.Session.Query("SELECT tokens FROM "+r.usersSearchTokensMetadata.Table+" WHERE user_id = ?", uuid)
+	statement.SetConsistency(r.consistencyLevels.IndexUser)
+	current := make([]string, 0)
+	err = statement.Scan(&current)
+	switch err != nil && !errors.Is(err, gocql.ErrNotFound) {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	isCurrent := make(map[string]bool, len(current))
+	for _, token := range current {
+		isCurrent[token] = true
+	}
+	isNew := make(map[string]bool, len(tokens))
+	batch := r.connection.Session.NewBatch(gocql.LoggedBatch)
+	for _, token := range tokens {
+		isNew[token] = true
+		switch isCurrent[token] {
+		case false:
+			batch.Query("INSERT INTO "+r.usersSearchMetadata.Table+" (token, user_id) VALUES (?, ?)", token, uuid)
+		}
+	}
+	for _, token := range current {
+		switch isNew[token] {
+		case false:
+			batch.Query("DELETE FROM "+r.usersSearchMetadata.Table+" WHERE token = ? AND user_id = ?", token, uuid)
+		}
+	}
+	switch len(tokens) == 0 {
+	case true:
+		batch.Query("DELETE FROM "+r.usersSearchTokensMetadata.Table+" WHERE user_id = ?", uuid)
+	default:
+		batch.Query("INSERT INTO "+r.usersSearchTokensMetadata.Table+" (user_id, tokens) VALUES (?, ?)", uuid, tokens)
+	}
+	batch.SetConsistency(r.consistencyLevels.IndexUser)
+	err = r.connection.Session.ExecuteBatch(batch)
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Users of a token are clustered by id so pages are fetched after the id of the last user of the previous page.
+ * Cursors that are not valid ids return an empty page.
+ */
+func (r Repository) SearchUsers(token string, limit int, cursor string) ([]string, string, error) {
+	ids := make([]string, 0, limit)
+	var statement *gocql.Query
+	switch cursor == "" {
+	case true:
+		statement = r.connection.Session.Query("SELECT user_id FROM "+r.usersSearchMetadata.Table+" WHERE token = ? LIMIT ?", token, limit+1)
+	default:
+		after, err := gocql.ParseUUID(cursor)
+		switch err != nil {
+		case true:
+			return ids, "", nil
+		}
+		statement = r.connection.Session.Query("SELECT user_id FROM "+r.usersSearchMetadata.Table+" WHERE token = ? AND user_id > ? LIMIT ?", token, after, limit+1)
+	}
+	statement.SetConsistency(r.consistencyLevels.SearchUsers)
+	iterator := statement.Iter()
+	var userId gocql.UUID
+	for iterator.Scan(&userId) {
+		ids = append(ids, userId.String())
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, "", errors2.InternalError{}
+	}
+	switch len(ids) > limit {
+	case true:
+		return ids[:limit], ids[limit-1], nil
+	}
+	return ids, "", nil
+}
+
+/**
+ * Update is conditional on existence of the user so that a deleted user is not recreated by a late status update
+ */
+func (r Repository) SetOnlineStatus(userId string, isOnline bool, lastSeen time.Time) error {
+	uuid, err := gocql.ParseUUID(userId)
+	switch err != nil {
+	case true:
+		return errors2.EntityNotFound{}
+	}
+	statement := r.connection.Session.Query("UPDATE "+r.usersMetadata.Table+" SET online_status = ?, last_seen = ? WHERE id = ? IF EXISTS", isOnline, lastSeen, uuid)
+	statement.SetConsistency(r.consistencyLevels.SetOnlineStatus)
+	applied, err := statement.MapScanCAS(map[string]interface{}{})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	switch applied {
+	case false:
+		return errors2.EntityNotFound{}
+	}
+	return nil
+}
+
+/**
+ * Last seen is compared with the one that is written by the caller. If another instance has written a newer
+ * last seen in the meantime, the user is online there and the update is not applied.
+ */
+func (r Repository) ExpireOnlineStatus(userId string, lastSeen time.Time, persistedAt time.Time) (bool, error) {
+	uuid, err := gocql.ParseUUID(userId)
+	switch err != nil {
+	case true:
+		return false, nil
+	}
+	statement := r.connection.Session.Query("UPDATE "+r.usersMetadata.Table+" SET online_status = ?, last_seen = ? WHERE id = ? IF last_seen = ?", false, lastSeen, uuid, persistedAt)
+	statement.SetConsistency(r.consistencyLevels.ExpireOnlineStatus)
+	applied, err := statement.MapScanCAS(map[string]interface{}{})
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return false, errors2.InternalError{}
+	}
+	return applied, nil
+}
+
+func (r Repository) SetPrivacyRule(rule domain.PrivacyRule) error {
+	statement := r.connection.Session.Query("INSERT INTO "+r.privacyRulesMetadata.Table+" (user_id, rule, visibility, allow_users, deny_users) VALUES (?, ?, ?, ?, ?)",
+		rule.UserId, rule.Rule, rule.Visibility, rule.AllowUsers, rule.DenyUsers)
+	statement.SetConsistency(r.consistencyLevels.SetPrivacyRule)
+	err := statement.Exec()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return errors2.InternalError{}
+	}
+	return nil
+}
+
+/**
+ * Fetches rules of all given users by a single IN query. Rules that users have not set are not returned.
+ * Ids that are not valid uuids are skipped.
+ */
+func (r Repository) GetPrivacyRules(userIds []string) ([]domain.PrivacyRule, error) {
+	rules := make([]domain.PrivacyRule, 0)
+	uuids := make([]gocql.UUID, 0, len(userIds))
+	for _, id := range userIds {
+		uuid, err := gocql.ParseUUID(id)
+		switch err != nil {
+		case true:
+			continue
+		}
+		uuids = append(uuids, uuid)
+	}
+	switch len(uuids) == 0 {
+	case true:
+		return rules, nil
+	}
+	statement := r.connection.Session.Query("SELECT user_id, rule, visibility, allow_users, deny_users FROM "+r.privacyRulesMetadata.Table+" WHERE user_id IN ?", uuids)
+	statement.SetConsistency(r.consistencyLevels.GetPrivacyRules)
+	iterator := statement.Iter()
+	var userId gocql.UUID
+	rule := domain.PrivacyRule{}
+	for iterator.Scan(&userId, &rule.Rule, &rule.Visibility, &rule.AllowUsers, &rule.DenyUsers) {
+		rule.UserId = userId.String()
+		rules = append(rules, rule)
+		rule = domain.PrivacyRule{}
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return rules, nil
+}
+
+/**
+ * Looks up ids of the users of the phones in users_pk_phone. Phones without a user are not included in the
+ * returned map which is keyed by phone
+ */
+func (r Repository) GetUserIdsByPhones(phones []string) (map[string]string, error) {
+	ids := make(map[string]string, len(phones))
+	for _, chunk := range splitValues(phones, maxInQuerySize) {
+		statement := r.connection.Session.Query("SELECT phone, id FROM "+r.usersPkPhoneMetadata.Table+" WHERE phone IN ?", chunk)
+		statement.SetConsistency(r.consistencyLevels.GetUserIdsByPhones)
+		iterator := statement.Iter()
+		var phone string
+		var id gocql.UUID
+		for iterator.Scan(&phone, &id) {
+			ids[phone] = id.String()
+		}
+		err := iterator.Close()
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return nil, errors2.InternalError{}
+		}
+	}
+	return ids, nil
+}
+
+/**
+ * Adds or replaces contacts together with their reverse rows. Contacts are written by logged batches so that
+ * contacts and reverse rows do not diverge
+ */
+func (r Repository) AddContacts(contacts []domain.Contact) error {
+	for start := 0; start < len(contacts); start += contactsBatchSize {
+		end := start + contactsBatchSize
+		switch end > len(contacts) {
+		case true:
+			end = len(contacts)
+		}
+		batch := r.connection.Session.NewBatch(gocql.LoggedBatch)
+		for _, contact := range contacts[start:end] {
+			batch.Query("INSERT INTO "+r.contactsMetadata.Table+" (owner_id, user_id, phone, name, lastname) VALUES (?, ?, ?, ?, ?)",
+				contact.OwnerId, contact.UserId, contact.Phone, contact.Name, contact.Lastname)
+			batch.Query("INSERT INTO "+r.contactsReverseMetadata.Table+" (user_id, owner_id) VALUES (?, ?)", contact.UserId, contact.OwnerId)
+		}
+		batch.SetConsistency(r.consistencyLevels.AddContacts)
+		err := r.connection.Session.ExecuteBatch(batch)
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return errors2.InternalError{}
+		}
+	}
+	return nil
+}
+
+func (r Repository) GetContacts(ownerId string) ([]domain.Contact, error) {
+	contacts := make([]domain.Contact, 0)
+	statement := r.connection.Session.Query("SELECT user_id, phone, name, lastname FROM "+r.contactsMetadata.Table+" WHERE owner_id = ?", ownerId)
+	statement.SetConsistency(r.consistencyLevels.GetContacts)
+	iterator := statement.Iter()
+	var userId gocql.UUID
+	contact := domain.Contact{
+		OwnerId: ownerId,
+	}
+	for iterator.Scan(&userId, &contact.Phone, &contact.Name, &contact.Lastname) {
+		contact.UserId = userId.String()
+		contacts = append(contacts, contact)
+		contact = domain.Contact{
+			OwnerId: ownerId,
+		}
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return contacts, nil
+}
+
+func (r Repository) DeleteContacts(ownerId string, userIds []string) error {
+	for _, chunk := range splitValues(userIds, contactsBatchSize) {
+		batch := r.connection.Session.NewBatch(gocql.LoggedBatch)
+		for _, userId := range chunk {
+			batch.Query("DELETE FROM "+r.contactsMetadata.Table+" WHERE owner_id = ? AND user_id = ?", ownerId, userId)
+			batch.Query("DELETE FROM "+r.contactsReverseMetadata.Table+" WHERE user_id = ? AND owner_id = ?", userId, ownerId)
+		}
+		batch.SetConsistency(r.consistencyLevels.DeleteContacts)
+		err := r.connection.Session.ExecuteBatch(batch)
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return errors2.InternalError{}
+		}
+	}
+	return nil
+}
+
+/**
+ * Returns the users among owners that have the user in their contacts. Owners that are not valid uuids are skipped
+ */
+func (r Repository) GetContactOwners(userId string, ownerIds []string) (map[string]bool, error) {
+	owners := make(map[string]bool)
+	uuids := make([]gocql.UUID, 0, len(ownerIds))
+	for _, id := range ownerIds {
+		uuid, err := gocql.ParseUUID(id)
+		switch err != nil {
+		case true:
+			continue
+		}
+		uuids = append(uuids, uuid)
+	}
+	for start := 0; start < len(uuids); start += maxInQuerySize {
+		end := start + maxInQuerySize
+		switch end > len(uuids) {
+		case true:
+			end = len(uuids)
+		}
+		statement := r.connection.Session.Query("SELECT owner_id FROM "+r.contactsReverseMetadata.Table+" WHERE user_id = ? AND owner_id IN ?", userId, uuids[start:end])
+		statement.SetConsistency(r.consistencyLevels.GetContactOwners)
+		iterator := statement.Iter()
+		var ownerId gocql.UUID
+		for iterator.Scan(&ownerId) {
+			owners[ownerId.String()] = true
+		}
+		err := iterator.Close()
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return nil, errors2.InternalError{}
+		}
+	}
+	return owners, nil
+}
+
+/**
+ * Returns ids of all users that have the user in their contacts
+ */
+func (r Repository) GetReverseContacts(userId string) ([]string, error) {
+	owners := make([]string, 0)
+	statement := r.connection.Session.Query("SELECT owner_id FROM "+r.contactsReverseMetadata.Table+" WHERE user_id = ?", userId)
+	statement.SetConsistency(r.consistencyLevels.GetReverseContacts)
+	iterator := statement.Iter()
+	var ownerId gocql.UUID
+	for iterator.Scan(&ownerId) {
+		owners = append(owners, ownerId.String())
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return owners, nil
+}
+
+/**
+ * Records phones that are imported by the owner. Records are expired after ttl.
+ */
+func (r Repository) RecordContactImports(ownerId string, phones []string, importedAt time.Time, ttl time.Duration) error {
+	for _, chunk := range splitValues(phones, contactsBatchSize) {
+		batch := r.connection.Session.NewBatch(gocql.UnloggedBatch)
+		for _, phone := range chunk {
+			batch.Query("INSERT INTO "+r.contactImportsMetadata.Table+" (owner_id, phone, imported_at) VALUES (?, ?, ?) USING TTL ?", ownerId, phone, importedAt, int(ttl.Seconds()))
+		}
+		batch.SetConsistency(r.consistencyLevels.RecordContactImports)
+		err := r.connection.Session.ExecuteBatch(batch)
+		switch err != nil {
+		case true:
+			reportQueryError(err)
+			return errors2.InternalError{}
+		}
+	}
+	return nil
+}
+
+/**
+ * Returns phones that are imported by the owner after since together with time of their import
+ */
+func (r Repository) GetContactImports(ownerId string, since time.Time) (map[string]time.Time, error) {
+	imports := make(map[string]time.Time)
+	statement := r.connection.Session.Query("SELECT phone, imported_at FROM "+r.contactImportsMetadata.Table+" WHERE owner_id = ?", ownerId)
+	statement.SetConsistency(r.consistencyLevels.GetContactImports)
+	iterator := statement.Iter()
+	var phone string
+	var importedAt time.Time
+	for iterator.Scan(&phone, &importedAt) {
+		switch importedAt.After(since) {
+		case true:
+			imports[phone] = importedAt
+		}
+	}
+	err := iterator.Close()
+	switch err != nil {
+	case true:
+		reportQueryError(err)
+		return nil, errors2.InternalError{}
+	}
+	return imports, nil
+}
+
+func splitValues(values []string, size int) [][]string {
+	chunks := make([][]string, 0, len(values)/size+1)
+	for start := 0; start < len(values); start += size {
+		end := start + size
+		switch end > len(values) {
+		case true:
+			end = len(values)
+		}
+		chunks = append(chunks, values[start:end])
+	}
+	return chunks
+}
+
+/**
+ * Reports errors to central error recorder
+ */
+func reportError(subject string, err error) {
+	errorReporter.ReportError("An error occurred while %s. Error message: %s", subject, err.Error())
+}
+
+func reportQueryError(err error) {
+	reportError("executing a query", err)
+}
+
+/**
+ * Returns ttl in seconds until given time. Cassandra rejects zero ttl as no ttl so at least 1 second is returned.
+ */
+func ttlUntil(t time.Time) int {
+	ttl := int(time.Until(t).Seconds())
+	switch ttl < 1 {
+	case true:
+		return 1
+	}
+	return ttl
 }
 
-func parseMicroSeconds(seconds int64) time.Time {
-	return time.Unix(0, 100*seconds)
+func parseMicroSeconds(microSeconds int64) time.Time {
+	return time.Unix(0, microSeconds*int64(time.Microsecond))
 }
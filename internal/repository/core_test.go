@@ -3,6 +3,7 @@ package repository
 import (
 	"github.com/zytell3301/tg-users-service/internal/domain"
 	uuid_generator "github.com/zytell3301/uuid-generator"
+	"sync"
 	"testing"
 	"time"
 )
@@ -22,8 +23,15 @@ var dummyUser = domain.User{
 //var dummyUserId = "5a087beb-4ba5-4583-b2a0-bce500395e1a"
 var keyspace = "tg"
 
+var dummyConfigs = Configs{
+	Hosts:             hosts,
+	Keyspace:          keyspace,
+	Port:              9042,
+	ConsistencyLevels: DefaultConsistencyLevel,
+}
+
 func TestNewUsersRepository(t *testing.T) {
-	repo, err := NewUsersRepository(hosts, keyspace, idGenerator, DefaultConsistencyLevel)
+	repo, err := NewUsersRepository(dummyConfigs, idGenerator)
 	switch err != nil || repo.connection.Session == nil || repo.connection.Cluster == nil {
 	case true:
 		t.Errorf("An error encountered while creating a new repo. Error: %v", err)
@@ -31,7 +39,9 @@ func TestNewUsersRepository(t *testing.T) {
 }
 
 func TestNewUsersRepository2(t *testing.T) {
-	_, err := NewUsersRepository(nil, keyspace, idGenerator, DefaultConsistencyLevel)
+	configs := dummyConfigs
+	configs.Hosts = nil
+	_, err := NewUsersRepository(configs, idGenerator)
 	switch err == nil {
 	case true:
 		t.Error("Expected to return error but no error returned")
@@ -41,26 +51,75 @@ func TestNewUsersRepository2(t *testing.T) {
 // Test fails if the number of current active nodes are less than highest RF (Here it is 3).
 // This error is not related to codes
 func TestRepository_NewUser(t *testing.T) {
-	repo, _ := NewUsersRepository(hosts, keyspace, idGenerator, DefaultConsistencyLevel)
-	err := repo.NewUser(dummyUser)
-	switch err != nil {
+	repo, _ := NewUsersRepository(dummyConfigs, idGenerator)
+	_, isCreated, err := repo.NewUser(dummyUser)
+	switch err != nil || !isCreated {
 	case true:
 		t.Errorf("An error encountered while adding a new user. Error: %v", err)
 	}
 }
 
 func TestRepository_UpdateUsername(t *testing.T) {
-	repo, _ := NewUsersRepository(hosts, keyspace, idGenerator, DefaultConsistencyLevel)
-	err := repo.UpdateUsername(dummyUser.Phone, "test_username")
-	switch err != nil {
+	repo, _ := NewUsersRepository(dummyConfigs, idGenerator)
+	isClaimed, err := repo.UpdateUsername(dummyUser.Phone, "test_username")
+	switch err != nil || !isClaimed {
 	case true:
 		t.Errorf("Expected method UpdateUsername to succeed but error returned. Error message: %v", err)
 	}
 }
 
+/**
+ * Test case for concurrent claims of a username by two users. Exactly one of the claims must succeed
+ */
+func TestRepository_UpdateUsername2(t *testing.T) {
+	repo, _ := NewUsersRepository(dummyConfigs, idGenerator)
+	users := []domain.User{dummyUser, dummyUser}
+	users[0].Phone, users[0].Username = "+09999999998", ""
+	users[1].Phone, users[1].Username = "+09999999997", ""
+	for i := range users {
+		id, isCreated, err := repo.NewUser(users[i])
+		switch err != nil || !isCreated {
+		case true:
+			t.Fatalf("An error encountered while creating user. Error: %v", err)
+		}
+		users[i].Id = id
+	}
+	username := "concurrent_username"
+	results := make([]bool, len(users))
+	errs := make([]error, len(users))
+	wg := sync.WaitGroup{}
+	for i := range users {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = repo.UpdateUsername(users[i].Phone, username)
+		}(i)
+	}
+	wg.Wait()
+	claims := 0
+	for i := range users {
+		switch errs[i] != nil {
+		case true:
+			t.Errorf("An error encountered while claiming username. Error: %v", errs[i])
+		}
+		switch results[i] {
+		case true:
+			claims++
+			users[i].Username = username
+		}
+	}
+	switch claims != 1 {
+	case true:
+		t.Errorf("Expected exactly one claim of username to succeed but %d claims succeeded", claims)
+	}
+	for _, user := range users {
+		_ = repo.DeleteUser(user)
+	}
+}
+
 func TestRepository_DeleteUser(t *testing.T) {
-	repo, _ := NewUsersRepository(hosts, keyspace, idGenerator,DefaultConsistencyLevel)
-	err := repo.DeleteUser(dummyUser.Phone)
+	repo, _ := NewUsersRepository(dummyConfigs, idGenerator)
+	err := repo.DeleteUser(dummyUser)
 	switch err != nil {
 	case true:
 		t.Errorf("An error encountered while deleting an existing user. Error: %v", err)
@@ -68,8 +127,8 @@ func TestRepository_DeleteUser(t *testing.T) {
 }
 
 func TestRepository_DeleteUser2(t *testing.T) {
-	repo, _ := NewUsersRepository(hosts, keyspace, idGenerator,DefaultConsistencyLevel)
-	err := repo.DeleteUser("")
+	repo, _ := NewUsersRepository(dummyConfigs, idGenerator)
+	err := repo.DeleteUser(domain.User{})
 	switch err == nil {
 	case true:
 		t.Error("Expected method DeleteUser to return error but no error returned")
@@ -6,13 +6,59 @@ import "github.com/gocql/gocql"
  * DEFAULT CONSISTENCY LEVELS MUST ONLY BE USED IN TEST ENVIRONMENTS
  */
 var DefaultConsistencyLevel = ConsistencyLevels{
-	NewUser:            gocql.One,
-	UpdateUsername:     gocql.One,
-	DeleteUser:         gocql.One,
-	DoesUserExists:     gocql.One,
-	DoesUsernameExists: gocql.One,
-	GetUserByUsername:  gocql.One,
-	GetUserByPhone:     gocql.One,
-	RecordSecurityCode: gocql.One,
-	GetSecurityCode:    gocql.One,
+	NewUser:                       gocql.One,
+	UpdateUsername:                gocql.One,
+	UpdateProfile:                 gocql.One,
+	ChangePhone:                   gocql.One,
+	DeleteUser:                    gocql.One,
+	DoesUserExists:                gocql.One,
+	DoesUsernameExists:            gocql.One,
+	GetUserByUsername:             gocql.One,
+	GetUserByPhone:                gocql.One,
+	GetUserById:                   gocql.One,
+	RecordSecurityCode:            gocql.One,
+	GetSecurityCode:               gocql.One,
+	IncrementSecurityCodeAttempts: gocql.One,
+	DeleteSecurityCode:            gocql.One,
+	ConsumeSecurityCode:           gocql.One,
+	RecordSecurityCodeRequest:     gocql.One,
+	GetSecurityCodeRequests:       gocql.One,
+	RecordCertificate:             gocql.One,
+	GetUserCertificates:           gocql.One,
+	RevokeCertificates:            gocql.One,
+	IsCertificateRevoked:          gocql.One,
+	GetRevokedCertificates:        gocql.One,
+	NewSession:                    gocql.One,
+	GetUserSessions:               gocql.One,
+	DeleteSessions:                gocql.One,
+	ScheduleAccountDeletion:       gocql.One,
+	GetAccountDeletion:            gocql.One,
+	CancelAccountDeletion:         gocql.One,
+	GetDueAccountDeletions:        gocql.One,
+	RecordAccountActivity:         gocql.One,
+	SetAccountTTL:                 gocql.One,
+	GetAccountTTL:                 gocql.One,
+	GetAccountActivities:          gocql.One,
+	DeleteAccountActivity:         gocql.One,
+	SetPassword:                   gocql.One,
+	GetPassword:                   gocql.One,
+	DeletePassword:                gocql.One,
+	RecordPendingLogin:            gocql.One,
+	GetPendingLogin:               gocql.One,
+	DeletePendingLogin:            gocql.One,
+	GetUsersByIds:                 gocql.One,
+	IndexUser:                     gocql.One,
+	SearchUsers:                   gocql.One,
+	SetOnlineStatus:               gocql.One,
+	ExpireOnlineStatus:            gocql.One,
+	SetPrivacyRule:                gocql.One,
+	GetPrivacyRules:               gocql.One,
+	GetUserIdsByPhones:            gocql.One,
+	AddContacts:                   gocql.One,
+	GetContacts:                   gocql.One,
+	DeleteContacts:                gocql.One,
+	GetContactOwners:              gocql.One,
+	GetReverseContacts:            gocql.One,
+	RecordContactImports:          gocql.One,
+	GetContactImports:             gocql.One,
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ package repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/zytell3301/tg-users-service/internal/domain"
@@ -34,18 +35,190 @@ func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddContacts mocks base method.
+func (m *MockUsersRepository) AddContacts(contacts []domain.Contact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddContacts", contacts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddContacts indicates an expected call of AddContacts.
+func (mr *MockUsersRepositoryMockRecorder) AddContacts(contacts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContacts", reflect.TypeOf((*MockUsersRepository)(nil).AddContacts), contacts)
+}
+
+// CancelAccountDeletion mocks base method.
+func (m *MockUsersRepository) CancelAccountDeletion(userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAccountDeletion", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAccountDeletion indicates an expected call of CancelAccountDeletion.
+func (mr *MockUsersRepositoryMockRecorder) CancelAccountDeletion(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountDeletion", reflect.TypeOf((*MockUsersRepository)(nil).CancelAccountDeletion), userId)
+}
+
+// ChangePhone mocks base method.
+func (m *MockUsersRepository) ChangePhone(user domain.User, phone string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePhone", user, phone)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePhone indicates an expected call of ChangePhone.
+func (mr *MockUsersRepositoryMockRecorder) ChangePhone(user, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePhone", reflect.TypeOf((*MockUsersRepository)(nil).ChangePhone), user, phone)
+}
+
+// ConsumeEmailCode mocks base method.
+func (m *MockUsersRepository) ConsumeEmailCode(userId, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeEmailCode", userId, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeEmailCode indicates an expected call of ConsumeEmailCode.
+func (mr *MockUsersRepositoryMockRecorder) ConsumeEmailCode(userId, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeEmailCode", reflect.TypeOf((*MockUsersRepository)(nil).ConsumeEmailCode), userId, code)
+}
+
+// ConsumeSecurityCode mocks base method.
+func (m *MockUsersRepository) ConsumeSecurityCode(phone, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeSecurityCode", phone, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeSecurityCode indicates an expected call of ConsumeSecurityCode.
+func (mr *MockUsersRepositoryMockRecorder) ConsumeSecurityCode(phone, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeSecurityCode", reflect.TypeOf((*MockUsersRepository)(nil).ConsumeSecurityCode), phone, code)
+}
+
+// DeleteAccountActivity mocks base method.
+func (m *MockUsersRepository) DeleteAccountActivity(userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountActivity", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountActivity indicates an expected call of DeleteAccountActivity.
+func (mr *MockUsersRepositoryMockRecorder) DeleteAccountActivity(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountActivity", reflect.TypeOf((*MockUsersRepository)(nil).DeleteAccountActivity), userId)
+}
+
+// DeleteContacts mocks base method.
+func (m *MockUsersRepository) DeleteContacts(ownerId string, userIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContacts", ownerId, userIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContacts indicates an expected call of DeleteContacts.
+func (mr *MockUsersRepositoryMockRecorder) DeleteContacts(ownerId, userIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContacts", reflect.TypeOf((*MockUsersRepository)(nil).DeleteContacts), ownerId, userIds)
+}
+
+// DeleteEmailCode mocks base method.
+func (m *MockUsersRepository) DeleteEmailCode(userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailCode", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmailCode indicates an expected call of DeleteEmailCode.
+func (mr *MockUsersRepositoryMockRecorder) DeleteEmailCode(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailCode", reflect.TypeOf((*MockUsersRepository)(nil).DeleteEmailCode), userId)
+}
+
+// DeletePassword mocks base method.
+func (m *MockUsersRepository) DeletePassword(userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePassword", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePassword indicates an expected call of DeletePassword.
+func (mr *MockUsersRepositoryMockRecorder) DeletePassword(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePassword", reflect.TypeOf((*MockUsersRepository)(nil).DeletePassword), userId)
+}
+
+// DeletePendingLogin mocks base method.
+func (m *MockUsersRepository) DeletePendingLogin(tokenHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingLogin", tokenHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePendingLogin indicates an expected call of DeletePendingLogin.
+func (mr *MockUsersRepositoryMockRecorder) DeletePendingLogin(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingLogin", reflect.TypeOf((*MockUsersRepository)(nil).DeletePendingLogin), tokenHash)
+}
+
+// DeleteSecurityCode mocks base method.
+func (m *MockUsersRepository) DeleteSecurityCode(phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityCode", phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityCode indicates an expected call of DeleteSecurityCode.
+func (mr *MockUsersRepositoryMockRecorder) DeleteSecurityCode(phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityCode", reflect.TypeOf((*MockUsersRepository)(nil).DeleteSecurityCode), phone)
+}
+
+// DeleteSessions mocks base method.
+func (m *MockUsersRepository) DeleteSessions(userId string, serials []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessions", userId, serials)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessions indicates an expected call of DeleteSessions.
+func (mr *MockUsersRepositoryMockRecorder) DeleteSessions(userId, serials interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessions", reflect.TypeOf((*MockUsersRepository)(nil).DeleteSessions), userId, serials)
+}
+
 // DeleteUser mocks base method.
-func (m *MockUsersRepository) DeleteUser(phone string) error {
+func (m *MockUsersRepository) DeleteUser(user domain.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", phone)
+	ret := m.ctrl.Call(m, "DeleteUser", user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUsersRepositoryMockRecorder) DeleteUser(phone interface{}) *gomock.Call {
+func (mr *MockUsersRepositoryMockRecorder) DeleteUser(user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUsersRepository)(nil).DeleteUser), phone)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUsersRepository)(nil).DeleteUser), user)
 }
 
 // DoesUserExists mocks base method.
@@ -78,6 +251,217 @@ func (mr *MockUsersRepositoryMockRecorder) DoesUsernameExists(username interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoesUsernameExists", reflect.TypeOf((*MockUsersRepository)(nil).DoesUsernameExists), username)
 }
 
+// ExpireOnlineStatus mocks base method.
+func (m *MockUsersRepository) ExpireOnlineStatus(userId string, lastSeen, persistedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOnlineStatus", userId, lastSeen, persistedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOnlineStatus indicates an expected call of ExpireOnlineStatus.
+func (mr *MockUsersRepositoryMockRecorder) ExpireOnlineStatus(userId, lastSeen, persistedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOnlineStatus", reflect.TypeOf((*MockUsersRepository)(nil).ExpireOnlineStatus), userId, lastSeen, persistedAt)
+}
+
+// GetAccountActivities mocks base method.
+func (m *MockUsersRepository) GetAccountActivities(pageState []byte) ([]domain.AccountActivity, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountActivities", pageState)
+	ret0, _ := ret[0].([]domain.AccountActivity)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccountActivities indicates an expected call of GetAccountActivities.
+func (mr *MockUsersRepositoryMockRecorder) GetAccountActivities(pageState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountActivities", reflect.TypeOf((*MockUsersRepository)(nil).GetAccountActivities), pageState)
+}
+
+// GetAccountDeletion mocks base method.
+func (m *MockUsersRepository) GetAccountDeletion(userId string) (domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountDeletion", userId)
+	ret0, _ := ret[0].(domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountDeletion indicates an expected call of GetAccountDeletion.
+func (mr *MockUsersRepositoryMockRecorder) GetAccountDeletion(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountDeletion", reflect.TypeOf((*MockUsersRepository)(nil).GetAccountDeletion), userId)
+}
+
+// GetAccountTTL mocks base method.
+func (m *MockUsersRepository) GetAccountTTL(userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountTTL", userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTTL indicates an expected call of GetAccountTTL.
+func (mr *MockUsersRepositoryMockRecorder) GetAccountTTL(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTTL", reflect.TypeOf((*MockUsersRepository)(nil).GetAccountTTL), userId)
+}
+
+// GetContactImports mocks base method.
+func (m *MockUsersRepository) GetContactImports(ownerId string, since time.Time) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactImports", ownerId, since)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactImports indicates an expected call of GetContactImports.
+func (mr *MockUsersRepositoryMockRecorder) GetContactImports(ownerId, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactImports", reflect.TypeOf((*MockUsersRepository)(nil).GetContactImports), ownerId, since)
+}
+
+// GetContactOwners mocks base method.
+func (m *MockUsersRepository) GetContactOwners(userId string, ownerIds []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactOwners", userId, ownerIds)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactOwners indicates an expected call of GetContactOwners.
+func (mr *MockUsersRepositoryMockRecorder) GetContactOwners(userId, ownerIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactOwners", reflect.TypeOf((*MockUsersRepository)(nil).GetContactOwners), userId, ownerIds)
+}
+
+// GetContacts mocks base method.
+func (m *MockUsersRepository) GetContacts(ownerId string) ([]domain.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContacts", ownerId)
+	ret0, _ := ret[0].([]domain.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContacts indicates an expected call of GetContacts.
+func (mr *MockUsersRepositoryMockRecorder) GetContacts(ownerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockUsersRepository)(nil).GetContacts), ownerId)
+}
+
+// GetDueAccountDeletions mocks base method.
+func (m *MockUsersRepository) GetDueAccountDeletions(until time.Time) ([]domain.AccountDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueAccountDeletions", until)
+	ret0, _ := ret[0].([]domain.AccountDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueAccountDeletions indicates an expected call of GetDueAccountDeletions.
+func (mr *MockUsersRepositoryMockRecorder) GetDueAccountDeletions(until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueAccountDeletions", reflect.TypeOf((*MockUsersRepository)(nil).GetDueAccountDeletions), until)
+}
+
+// GetEmailCode mocks base method.
+func (m *MockUsersRepository) GetEmailCode(userId string) (domain.SecurityCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailCode", userId)
+	ret0, _ := ret[0].(domain.SecurityCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailCode indicates an expected call of GetEmailCode.
+func (mr *MockUsersRepositoryMockRecorder) GetEmailCode(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailCode", reflect.TypeOf((*MockUsersRepository)(nil).GetEmailCode), userId)
+}
+
+// GetPassword mocks base method.
+func (m *MockUsersRepository) GetPassword(userId string) (domain.Password, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassword", userId)
+	ret0, _ := ret[0].(domain.Password)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassword indicates an expected call of GetPassword.
+func (mr *MockUsersRepositoryMockRecorder) GetPassword(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassword", reflect.TypeOf((*MockUsersRepository)(nil).GetPassword), userId)
+}
+
+// GetPendingLogin mocks base method.
+func (m *MockUsersRepository) GetPendingLogin(tokenHash string) (domain.PendingLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingLogin", tokenHash)
+	ret0, _ := ret[0].(domain.PendingLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingLogin indicates an expected call of GetPendingLogin.
+func (mr *MockUsersRepositoryMockRecorder) GetPendingLogin(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingLogin", reflect.TypeOf((*MockUsersRepository)(nil).GetPendingLogin), tokenHash)
+}
+
+// GetPrivacyRules mocks base method.
+func (m *MockUsersRepository) GetPrivacyRules(userIds []string) ([]domain.PrivacyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacyRules", userIds)
+	ret0, _ := ret[0].([]domain.PrivacyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacyRules indicates an expected call of GetPrivacyRules.
+func (mr *MockUsersRepositoryMockRecorder) GetPrivacyRules(userIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacyRules", reflect.TypeOf((*MockUsersRepository)(nil).GetPrivacyRules), userIds)
+}
+
+// GetReverseContacts mocks base method.
+func (m *MockUsersRepository) GetReverseContacts(userId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReverseContacts", userId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReverseContacts indicates an expected call of GetReverseContacts.
+func (mr *MockUsersRepositoryMockRecorder) GetReverseContacts(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReverseContacts", reflect.TypeOf((*MockUsersRepository)(nil).GetReverseContacts), userId)
+}
+
+// GetRevokedCertificates mocks base method.
+func (m *MockUsersRepository) GetRevokedCertificates() ([]domain.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedCertificates")
+	ret0, _ := ret[0].([]domain.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevokedCertificates indicates an expected call of GetRevokedCertificates.
+func (mr *MockUsersRepositoryMockRecorder) GetRevokedCertificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedCertificates", reflect.TypeOf((*MockUsersRepository)(nil).GetRevokedCertificates))
+}
+
 // GetSecurityCode mocks base method.
 func (m *MockUsersRepository) GetSecurityCode(phone string) (domain.SecurityCode, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +477,36 @@ func (mr *MockUsersRepositoryMockRecorder) GetSecurityCode(phone interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityCode", reflect.TypeOf((*MockUsersRepository)(nil).GetSecurityCode), phone)
 }
 
+// GetSecurityCodeRequests mocks base method.
+func (m *MockUsersRepository) GetSecurityCodeRequests(key string, since time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityCodeRequests", key, since)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityCodeRequests indicates an expected call of GetSecurityCodeRequests.
+func (mr *MockUsersRepositoryMockRecorder) GetSecurityCodeRequests(key, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityCodeRequests", reflect.TypeOf((*MockUsersRepository)(nil).GetSecurityCodeRequests), key, since)
+}
+
+// GetUserById mocks base method.
+func (m *MockUsersRepository) GetUserById(id string) (domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserById", id)
+	ret0, _ := ret[0].(domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserById indicates an expected call of GetUserById.
+func (mr *MockUsersRepositoryMockRecorder) GetUserById(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserById", reflect.TypeOf((*MockUsersRepository)(nil).GetUserById), id)
+}
+
 // GetUserByPhone mocks base method.
 func (m *MockUsersRepository) GetUserByPhone(phone string) (domain.User, error) {
 	m.ctrl.T.Helper()
@@ -123,20 +537,211 @@ func (mr *MockUsersRepositoryMockRecorder) GetUserByUsername(username interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUsersRepository)(nil).GetUserByUsername), username)
 }
 
-// NewUser mocks base method.
-func (m *MockUsersRepository) NewUser(user domain.User) error {
+// GetUserCertificates mocks base method.
+func (m *MockUsersRepository) GetUserCertificates(userId string) ([]domain.Certificate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewUser", user)
+	ret := m.ctrl.Call(m, "GetUserCertificates", userId)
+	ret0, _ := ret[0].([]domain.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCertificates indicates an expected call of GetUserCertificates.
+func (mr *MockUsersRepositoryMockRecorder) GetUserCertificates(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCertificates", reflect.TypeOf((*MockUsersRepository)(nil).GetUserCertificates), userId)
+}
+
+// GetUserIdsByPhones mocks base method.
+func (m *MockUsersRepository) GetUserIdsByPhones(phones []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdsByPhones", phones)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdsByPhones indicates an expected call of GetUserIdsByPhones.
+func (mr *MockUsersRepositoryMockRecorder) GetUserIdsByPhones(phones interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdsByPhones", reflect.TypeOf((*MockUsersRepository)(nil).GetUserIdsByPhones), phones)
+}
+
+// GetUserSessions mocks base method.
+func (m *MockUsersRepository) GetUserSessions(userId string) ([]domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", userId)
+	ret0, _ := ret[0].([]domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockUsersRepositoryMockRecorder) GetUserSessions(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockUsersRepository)(nil).GetUserSessions), userId)
+}
+
+// GetUsersByIds mocks base method.
+func (m *MockUsersRepository) GetUsersByIds(ids []string) (map[string]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIds", ids)
+	ret0, _ := ret[0].(map[string]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIds indicates an expected call of GetUsersByIds.
+func (mr *MockUsersRepositoryMockRecorder) GetUsersByIds(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIds", reflect.TypeOf((*MockUsersRepository)(nil).GetUsersByIds), ids)
+}
+
+// IncrementEmailCodeAttempts mocks base method.
+func (m *MockUsersRepository) IncrementEmailCodeAttempts(userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementEmailCodeAttempts", userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementEmailCodeAttempts indicates an expected call of IncrementEmailCodeAttempts.
+func (mr *MockUsersRepositoryMockRecorder) IncrementEmailCodeAttempts(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementEmailCodeAttempts", reflect.TypeOf((*MockUsersRepository)(nil).IncrementEmailCodeAttempts), userId)
+}
+
+// IncrementSecurityCodeAttempts mocks base method.
+func (m *MockUsersRepository) IncrementSecurityCodeAttempts(phone string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementSecurityCodeAttempts", phone)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementSecurityCodeAttempts indicates an expected call of IncrementSecurityCodeAttempts.
+func (mr *MockUsersRepositoryMockRecorder) IncrementSecurityCodeAttempts(phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementSecurityCodeAttempts", reflect.TypeOf((*MockUsersRepository)(nil).IncrementSecurityCodeAttempts), phone)
+}
+
+// IsCertificateRevoked mocks base method.
+func (m *MockUsersRepository) IsCertificateRevoked(serial string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCertificateRevoked", serial)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCertificateRevoked indicates an expected call of IsCertificateRevoked.
+func (mr *MockUsersRepositoryMockRecorder) IsCertificateRevoked(serial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCertificateRevoked", reflect.TypeOf((*MockUsersRepository)(nil).IsCertificateRevoked), serial)
+}
+
+// NewSession mocks base method.
+func (m *MockUsersRepository) NewSession(session domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSession", session)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// NewSession indicates an expected call of NewSession.
+func (mr *MockUsersRepositoryMockRecorder) NewSession(session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockUsersRepository)(nil).NewSession), session)
+}
+
+// NewUser mocks base method.
+func (m *MockUsersRepository) NewUser(user domain.User) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUser", user)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // NewUser indicates an expected call of NewUser.
 func (mr *MockUsersRepositoryMockRecorder) NewUser(user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUser", reflect.TypeOf((*MockUsersRepository)(nil).NewUser), user)
 }
 
+// RecordAccountActivity mocks base method.
+func (m *MockUsersRepository) RecordAccountActivity(userId string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAccountActivity", userId, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAccountActivity indicates an expected call of RecordAccountActivity.
+func (mr *MockUsersRepositoryMockRecorder) RecordAccountActivity(userId, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAccountActivity", reflect.TypeOf((*MockUsersRepository)(nil).RecordAccountActivity), userId, at)
+}
+
+// RecordCertificate mocks base method.
+func (m *MockUsersRepository) RecordCertificate(certificate domain.Certificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCertificate", certificate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCertificate indicates an expected call of RecordCertificate.
+func (mr *MockUsersRepositoryMockRecorder) RecordCertificate(certificate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCertificate", reflect.TypeOf((*MockUsersRepository)(nil).RecordCertificate), certificate)
+}
+
+// RecordContactImports mocks base method.
+func (m *MockUsersRepository) RecordContactImports(ownerId string, phones []string, importedAt time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordContactImports", ownerId, phones, importedAt, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordContactImports indicates an expected call of RecordContactImports.
+func (mr *MockUsersRepositoryMockRecorder) RecordContactImports(ownerId, phones, importedAt, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordContactImports", reflect.TypeOf((*MockUsersRepository)(nil).RecordContactImports), ownerId, phones, importedAt, ttl)
+}
+
+// RecordEmailCode mocks base method.
+func (m *MockUsersRepository) RecordEmailCode(securityCode domain.SecurityCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEmailCode", securityCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEmailCode indicates an expected call of RecordEmailCode.
+func (mr *MockUsersRepositoryMockRecorder) RecordEmailCode(securityCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEmailCode", reflect.TypeOf((*MockUsersRepository)(nil).RecordEmailCode), securityCode)
+}
+
+// RecordPendingLogin mocks base method.
+func (m *MockUsersRepository) RecordPendingLogin(login domain.PendingLogin, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPendingLogin", login, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPendingLogin indicates an expected call of RecordPendingLogin.
+func (mr *MockUsersRepositoryMockRecorder) RecordPendingLogin(login, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPendingLogin", reflect.TypeOf((*MockUsersRepository)(nil).RecordPendingLogin), login, ttl)
+}
+
 // RecordSecurityCode mocks base method.
 func (m *MockUsersRepository) RecordSecurityCode(securityCode domain.SecurityCode) error {
 	m.ctrl.T.Helper()
@@ -151,14 +756,127 @@ func (mr *MockUsersRepositoryMockRecorder) RecordSecurityCode(securityCode inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecurityCode", reflect.TypeOf((*MockUsersRepository)(nil).RecordSecurityCode), securityCode)
 }
 
-// UpdateUsername mocks base method.
-func (m *MockUsersRepository) UpdateUsername(phone, username string) error {
+// RecordSecurityCodeRequest mocks base method.
+func (m *MockUsersRepository) RecordSecurityCodeRequest(key string, requestedAt time.Time, ttl time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUsername", phone, username)
+	ret := m.ctrl.Call(m, "RecordSecurityCodeRequest", key, requestedAt, ttl)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RecordSecurityCodeRequest indicates an expected call of RecordSecurityCodeRequest.
+func (mr *MockUsersRepositoryMockRecorder) RecordSecurityCodeRequest(key, requestedAt, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecurityCodeRequest", reflect.TypeOf((*MockUsersRepository)(nil).RecordSecurityCodeRequest), key, requestedAt, ttl)
+}
+
+// RevokeCertificates mocks base method.
+func (m *MockUsersRepository) RevokeCertificates(certificates []domain.Certificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificates", certificates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCertificates indicates an expected call of RevokeCertificates.
+func (mr *MockUsersRepositoryMockRecorder) RevokeCertificates(certificates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificates", reflect.TypeOf((*MockUsersRepository)(nil).RevokeCertificates), certificates)
+}
+
+// ScheduleAccountDeletion mocks base method.
+func (m *MockUsersRepository) ScheduleAccountDeletion(deletion domain.AccountDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAccountDeletion", deletion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleAccountDeletion indicates an expected call of ScheduleAccountDeletion.
+func (mr *MockUsersRepositoryMockRecorder) ScheduleAccountDeletion(deletion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAccountDeletion", reflect.TypeOf((*MockUsersRepository)(nil).ScheduleAccountDeletion), deletion)
+}
+
+// SetAccountTTL mocks base method.
+func (m *MockUsersRepository) SetAccountTTL(userId string, ttlDays int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountTTL", userId, ttlDays)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountTTL indicates an expected call of SetAccountTTL.
+func (mr *MockUsersRepositoryMockRecorder) SetAccountTTL(userId, ttlDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountTTL", reflect.TypeOf((*MockUsersRepository)(nil).SetAccountTTL), userId, ttlDays)
+}
+
+// SetOnlineStatus mocks base method.
+func (m *MockUsersRepository) SetOnlineStatus(userId string, isOnline bool, lastSeen time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOnlineStatus", userId, isOnline, lastSeen)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOnlineStatus indicates an expected call of SetOnlineStatus.
+func (mr *MockUsersRepositoryMockRecorder) SetOnlineStatus(userId, isOnline, lastSeen interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnlineStatus", reflect.TypeOf((*MockUsersRepository)(nil).SetOnlineStatus), userId, isOnline, lastSeen)
+}
+
+// SetPassword mocks base method.
+func (m *MockUsersRepository) SetPassword(password domain.Password) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockUsersRepositoryMockRecorder) SetPassword(password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockUsersRepository)(nil).SetPassword), password)
+}
+
+// SetPrivacyRule mocks base method.
+func (m *MockUsersRepository) SetPrivacyRule(rule domain.PrivacyRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivacyRule", rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrivacyRule indicates an expected call of SetPrivacyRule.
+func (mr *MockUsersRepositoryMockRecorder) SetPrivacyRule(rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivacyRule", reflect.TypeOf((*MockUsersRepository)(nil).SetPrivacyRule), rule)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUsersRepository) UpdateProfile(user domain.User, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", user, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUsersRepositoryMockRecorder) UpdateProfile(user, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUsersRepository)(nil).UpdateProfile), user, fields)
+}
+
+// UpdateUsername mocks base method.
+func (m *MockUsersRepository) UpdateUsername(phone, username string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsername", phone, username)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateUsername indicates an expected call of UpdateUsername.
 func (mr *MockUsersRepositoryMockRecorder) UpdateUsername(phone, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -0,0 +1,73 @@
+package searchIndex
+
+import (
+	"sort"
+	"sync"
+)
+
+/**
+ * MemoryIndex keeps the search index in memory of the instance. It is lost on restart and not shared
+ * between instances so it MUST only be used in tests and development environments.
+ */
+type MemoryIndex struct {
+	lock *sync.RWMutex
+	// Ids of the users of every token
+	users map[string]map[string]bool
+	// Current tokens of every user
+	tokens map[string][]string
+}
+
+func NewMemoryIndex() MemoryIndex {
+	return MemoryIndex{
+		lock:   &sync.RWMutex{},
+		users:  map[string]map[string]bool{},
+		tokens: map[string][]string{},
+	}
+}
+
+func (m MemoryIndex) IndexUser(userId string, tokens []string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, token := range m.tokens[userId] {
+		delete(m.users[token], userId)
+		switch len(m.users[token]) == 0 {
+		case true:
+			delete(m.users, token)
+		}
+	}
+	delete(m.tokens, userId)
+	switch len(tokens) == 0 {
+	case true:
+		return nil
+	}
+	for _, token := range tokens {
+		switch m.users[token] == nil {
+		case true:
+			m.users[token] = map[string]bool{}
+		}
+		m.users[token][userId] = true
+	}
+	m.tokens[userId] = append([]string{}, tokens...)
+	return nil
+}
+
+/**
+ * Ids are returned in lexical order and cursor is the last id of the previous page
+ */
+func (m MemoryIndex) SearchUsers(token string, limit int, cursor string) ([]string, string, error) {
+	m.lock.RLock()
+	ids := make([]string, 0, len(m.users[token]))
+	for id := range m.users[token] {
+		switch id > cursor {
+		case true:
+			ids = append(ids, id)
+		}
+	}
+	m.lock.RUnlock()
+	sort.Strings(ids)
+	switch len(ids) > limit {
+	case true:
+		return ids[:limit], ids[limit-1], nil
+	}
+	return ids, "", nil
+}
@@ -1,11 +1,16 @@
 package CertGen
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"math/big"
+	"time"
 )
 
 type CertGen struct {
@@ -16,6 +21,13 @@ type CertGen struct {
 
 var max = new(big.Int)
 
+const MinRsaKeySize = 2048
+
+var (
+	ErrWeakKey        = errors.New("public key is too weak")
+	ErrUnsupportedKey = errors.New("public key algorithm is not supported")
+)
+
 func init() {
 	max.Exp(big.NewInt(2), big.NewInt(130), nil).Sub(max, big.NewInt(1))
 }
@@ -40,9 +52,44 @@ func NewCertGenerator(CaCert []byte, CaKey []byte) (CertGen, error) {
 	return gen, nil
 }
 
-func (c CertGen) NewCertificate(cert *x509.Certificate) ([]byte, error) {
+/**
+ * Signs given certificate template over publicKey with CA key. Serial number of the template is overwritten.
+ * Returned value is the PEM encoded chain of the issued certificate followed by the CA certificate.
+ */
+func (c CertGen) NewCertificate(cert *x509.Certificate, publicKey interface{}) ([]byte, error) {
 	cert.SerialNumber = GenerateUniqueId()
-	return x509.CreateCertificate(rand.Reader, cert, c.CaCert, c.CaCert.PublicKey, c.CaKey)
+	der, err := x509.CreateCertificate(rand.Reader, cert, c.CaCert, publicKey, c.CaKey)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.CaCert.Raw})...), nil
+}
+
+/**
+ * Verifies that the certificate is a client certificate issued by CA
+ */
+func (c CertGen) VerifyCertificate(cert *x509.Certificate) error {
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     c.pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+/**
+ * Creates a DER encoded certificate revocation list signed by CA key. CA certificate must have cRLSign key usage.
+ * The list is valid until nextUpdate and its number is derived from current time so that it always increases.
+ */
+func (c CertGen) NewRevocationList(revoked []pkix.RevokedCertificate, nextUpdate time.Time) ([]byte, error) {
+	now := time.Now()
+	return x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificates: revoked,
+		Number:              big.NewInt(now.UnixNano()),
+		ThisUpdate:          now,
+		NextUpdate:          nextUpdate,
+	}, c.CaCert, c.CaKey)
 }
 
 func GenerateUniqueId() *big.Int {
@@ -60,6 +107,58 @@ func DecodePem(cert []byte) *pem.Block {
 	return block
 }
 
+/**
+ * Parses a PKIX public key. Key can be either PEM encoded or raw DER bytes
+ */
+func ParsePublicKey(key []byte) (interface{}, error) {
+	block := DecodePem(key)
+	switch block != nil {
+	case true:
+		key = block.Bytes
+	}
+	return x509.ParsePKIXPublicKey(key)
+}
+
+/**
+ * Parses a PKCS#10 certificate request and verifies its signature. Request can be either PEM encoded or raw DER bytes
+ */
+func ParseCertificateRequest(request []byte) (*x509.CertificateRequest, error) {
+	block := DecodePem(request)
+	switch block != nil {
+	case true:
+		request = block.Bytes
+	}
+	csr, err := x509.ParseCertificateRequest(request)
+	switch err != nil {
+	case true:
+		return nil, err
+	}
+	return csr, csr.CheckSignature()
+}
+
+/**
+ * Rejects keys that are too weak to be certified.
+ * RSA keys must be at least MinRsaKeySize bits and ECDSA keys must use P-256 or stronger curves.
+ */
+func CheckPublicKey(key interface{}) error {
+	switch publicKey := key.(type) {
+	case *rsa.PublicKey:
+		switch publicKey.N.BitLen() < MinRsaKeySize {
+		case true:
+			return ErrWeakKey
+		}
+	case *ecdsa.PublicKey:
+		switch publicKey.Curve.Params().BitSize < 256 {
+		case true:
+			return ErrWeakKey
+		}
+	case ed25519.PublicKey:
+	default:
+		return ErrUnsupportedKey
+	}
+	return nil
+}
+
 func ParsePKCS1PrivateKey(key []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(DecodePem(key).Bytes)
 }
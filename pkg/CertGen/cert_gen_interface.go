@@ -1,7 +1,13 @@
 package CertGen
 
-import "crypto/x509"
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+)
 
 type Gen interface {
-	NewCertificate(cert *x509.Certificate) ([]byte, error)
+	NewCertificate(cert *x509.Certificate, publicKey interface{}) ([]byte, error)
+	VerifyCertificate(cert *x509.Certificate) error
+	NewRevocationList(revoked []pkix.RevokedCertificate, nextUpdate time.Time) ([]byte, error)
 }
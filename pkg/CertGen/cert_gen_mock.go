@@ -6,7 +6,9 @@ package CertGen
 
 import (
 	x509 "crypto/x509"
+	pkix "crypto/x509/pkix"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,16 +37,45 @@ func (m *MockGen) EXPECT() *MockGenMockRecorder {
 }
 
 // NewCertificate mocks base method.
-func (m *MockGen) NewCertificate(cert *x509.Certificate) ([]byte, error) {
+func (m *MockGen) NewCertificate(cert *x509.Certificate, publicKey interface{}) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewCertificate", cert)
+	ret := m.ctrl.Call(m, "NewCertificate", cert, publicKey)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewCertificate indicates an expected call of NewCertificate.
-func (mr *MockGenMockRecorder) NewCertificate(cert interface{}) *gomock.Call {
+func (mr *MockGenMockRecorder) NewCertificate(cert, publicKey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCertificate", reflect.TypeOf((*MockGen)(nil).NewCertificate), cert)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCertificate", reflect.TypeOf((*MockGen)(nil).NewCertificate), cert, publicKey)
+}
+
+// NewRevocationList mocks base method.
+func (m *MockGen) NewRevocationList(revoked []pkix.RevokedCertificate, nextUpdate time.Time) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRevocationList", revoked, nextUpdate)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRevocationList indicates an expected call of NewRevocationList.
+func (mr *MockGenMockRecorder) NewRevocationList(revoked, nextUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRevocationList", reflect.TypeOf((*MockGen)(nil).NewRevocationList), revoked, nextUpdate)
+}
+
+// VerifyCertificate mocks base method.
+func (m *MockGen) VerifyCertificate(cert *x509.Certificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCertificate", cert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyCertificate indicates an expected call of VerifyCertificate.
+func (mr *MockGenMockRecorder) VerifyCertificate(cert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCertificate", reflect.TypeOf((*MockGen)(nil).VerifyCertificate), cert)
 }
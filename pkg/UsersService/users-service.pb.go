@@ -10,6 +10,7 @@ import (
 	error1 "github.com/zytell3301/tg-users-service/pkg/error"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +22,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Coarse statuses are used instead of the exact last seen time when it is hidden
+type LastSeenStatus int32
+
+const (
+	LastSeenStatus_LAST_SEEN_EXACT LastSeenStatus = 0
+	// Within 3 days
+	LastSeenStatus_LAST_SEEN_RECENTLY     LastSeenStatus = 1
+	LastSeenStatus_LAST_SEEN_WITHIN_WEEK  LastSeenStatus = 2
+	LastSeenStatus_LAST_SEEN_WITHIN_MONTH LastSeenStatus = 3
+	LastSeenStatus_LAST_SEEN_LONG_AGO     LastSeenStatus = 4
+)
+
+// Enum value maps for LastSeenStatus.
+var (
+	LastSeenStatus_name = map[int32]string{
+		0: "LAST_SEEN_EXACT",
+		1: "LAST_SEEN_RECENTLY",
+		2: "LAST_SEEN_WITHIN_WEEK",
+		3: "LAST_SEEN_WITHIN_MONTH",
+		4: "LAST_SEEN_LONG_AGO",
+	}
+	LastSeenStatus_value = map[string]int32{
+		"LAST_SEEN_EXACT":        0,
+		"LAST_SEEN_RECENTLY":     1,
+		"LAST_SEEN_WITHIN_WEEK":  2,
+		"LAST_SEEN_WITHIN_MONTH": 3,
+		"LAST_SEEN_LONG_AGO":     4,
+	}
+)
+
+func (x LastSeenStatus) Enum() *LastSeenStatus {
+	p := new(LastSeenStatus)
+	*p = x
+	return p
+}
+
+func (x LastSeenStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LastSeenStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_pb_UsersService_users_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LastSeenStatus) Type() protoreflect.EnumType {
+	return &file_api_pb_UsersService_users_service_proto_enumTypes[0]
+}
+
+func (x LastSeenStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LastSeenStatus.Descriptor instead.
+func (LastSeenStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{0}
+}
+
 type GetUserByUsernameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -123,17 +181,16 @@ func (x *GetUserByUsernameResponse) GetError() *error1.Error {
 	return nil
 }
 
-type UpdateUsernameMessage struct {
+type GetUserByIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone    string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 }
 
-func (x *UpdateUsernameMessage) Reset() {
-	*x = UpdateUsernameMessage{}
+func (x *GetUserByIdRequest) Reset() {
+	*x = GetUserByIdRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -141,13 +198,13 @@ func (x *UpdateUsernameMessage) Reset() {
 	}
 }
 
-func (x *UpdateUsernameMessage) String() string {
+func (x *GetUserByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUsernameMessage) ProtoMessage() {}
+func (*GetUserByIdRequest) ProtoMessage() {}
 
-func (x *UpdateUsernameMessage) ProtoReflect() protoreflect.Message {
+func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,36 +216,29 @@ func (x *UpdateUsernameMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUsernameMessage.ProtoReflect.Descriptor instead.
-func (*UpdateUsernameMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdateUsernameMessage) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
-}
-
-func (x *UpdateUsernameMessage) GetUsername() string {
+func (x *GetUserByIdRequest) GetId() string {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
 	return ""
 }
 
-type LoginRequest struct {
+type GetUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecurityCode *SecurityCode `protobuf:"bytes,1,opt,name=securityCode,proto3" json:"securityCode,omitempty"`
-	Phone        string        `protobuf:"bytes,2,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	User  *User         `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Error *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,13 +246,13 @@ func (x *LoginRequest) Reset() {
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *GetUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*GetUserResponse) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -214,37 +264,35 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoginRequest) GetSecurityCode() *SecurityCode {
+func (x *GetUserResponse) GetUser() *User {
 	if x != nil {
-		return x.SecurityCode
+		return x.User
 	}
 	return nil
 }
 
-func (x *LoginRequest) GetPhone() string {
+func (x *GetUserResponse) GetError() *error1.Error {
 	if x != nil {
-		return x.Phone
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type VerifySecurityCodeRequest struct {
+type GetUsersByIdsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone        string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
-	SecurityCode string `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
-	Action       string `protobuf:"bytes,3,opt,name=Action,proto3" json:"Action,omitempty"`
+	Ids []string `protobuf:"bytes,1,rep,name=Ids,proto3" json:"Ids,omitempty"`
 }
 
-func (x *VerifySecurityCodeRequest) Reset() {
-	*x = VerifySecurityCodeRequest{}
+func (x *GetUsersByIdsRequest) Reset() {
+	*x = GetUsersByIdsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -252,13 +300,13 @@ func (x *VerifySecurityCodeRequest) Reset() {
 	}
 }
 
-func (x *VerifySecurityCodeRequest) String() string {
+func (x *GetUsersByIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifySecurityCodeRequest) ProtoMessage() {}
+func (*GetUsersByIdsRequest) ProtoMessage() {}
 
-func (x *VerifySecurityCodeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,43 +318,31 @@ func (x *VerifySecurityCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifySecurityCodeRequest.ProtoReflect.Descriptor instead.
-func (*VerifySecurityCodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUsersByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsRequest) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *VerifySecurityCodeRequest) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
-}
-
-func (x *VerifySecurityCodeRequest) GetSecurityCode() string {
-	if x != nil {
-		return x.SecurityCode
-	}
-	return ""
-}
-
-func (x *VerifySecurityCodeRequest) GetAction() string {
+func (x *GetUsersByIdsRequest) GetIds() []string {
 	if x != nil {
-		return x.Action
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type LoginResponse struct {
+type UserLookupResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Certificate []byte        `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
-	Error       *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	// Not set if Found is false
+	User  *User `protobuf:"bytes,2,opt,name=User,proto3" json:"User,omitempty"`
+	Found bool  `protobuf:"varint,3,opt,name=Found,proto3" json:"Found,omitempty"`
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
+func (x *UserLookupResult) Reset() {
+	*x = UserLookupResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,13 +350,13 @@ func (x *LoginResponse) Reset() {
 	}
 }
 
-func (x *LoginResponse) String() string {
+func (x *UserLookupResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*UserLookupResult) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+func (x *UserLookupResult) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,36 +368,43 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserLookupResult.ProtoReflect.Descriptor instead.
+func (*UserLookupResult) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LoginResponse) GetCertificate() []byte {
+func (x *UserLookupResult) GetId() string {
 	if x != nil {
-		return x.Certificate
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *LoginResponse) GetError() *error1.Error {
+func (x *UserLookupResult) GetUser() *User {
 	if x != nil {
-		return x.Error
+		return x.User
 	}
 	return nil
 }
 
-type NewUserMessage struct {
+func (x *UserLookupResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type GetUsersByIdsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User         *User         `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
-	SecurityCode *SecurityCode `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+	Results []*UserLookupResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	Error   *error1.Error       `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *NewUserMessage) Reset() {
-	*x = NewUserMessage{}
+func (x *GetUsersByIdsResponse) Reset() {
+	*x = GetUsersByIdsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,13 +412,13 @@ func (x *NewUserMessage) Reset() {
 	}
 }
 
-func (x *NewUserMessage) String() string {
+func (x *GetUsersByIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewUserMessage) ProtoMessage() {}
+func (*GetUsersByIdsResponse) ProtoMessage() {}
 
-func (x *NewUserMessage) ProtoReflect() protoreflect.Message {
+func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,35 +430,39 @@ func (x *NewUserMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewUserMessage.ProtoReflect.Descriptor instead.
-func (*NewUserMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUsersByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsResponse) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *NewUserMessage) GetUser() *User {
+func (x *GetUsersByIdsResponse) GetResults() []*UserLookupResult {
 	if x != nil {
-		return x.User
+		return x.Results
 	}
 	return nil
 }
 
-func (x *NewUserMessage) GetSecurityCode() *SecurityCode {
+func (x *GetUsersByIdsResponse) GetError() *error1.Error {
 	if x != nil {
-		return x.SecurityCode
+		return x.Error
 	}
 	return nil
 }
 
-type Phone struct {
+type SearchUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Zero means the default page size. Larger values than the maximum page size are truncated
+	Limit int32 `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// NextPageToken of the previous page. Empty for the first page
+	PageToken string `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
 }
 
-func (x *Phone) Reset() {
-	*x = Phone{}
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,13 +470,13 @@ func (x *Phone) Reset() {
 	}
 }
 
-func (x *Phone) String() string {
+func (x *SearchUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Phone) ProtoMessage() {}
+func (*SearchUsersRequest) ProtoMessage() {}
 
-func (x *Phone) ProtoReflect() protoreflect.Message {
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,35 +488,45 @@ func (x *Phone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
-func (*Phone) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Phone) GetPhone() string {
+func (x *SearchUsersRequest) GetQuery() string {
 	if x != nil {
-		return x.Phone
+		return x.Query
 	}
 	return ""
 }
 
-type User struct {
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Lastname     string `protobuf:"bytes,3,opt,name=Lastname,proto3" json:"Lastname,omitempty"`
-	Bio          string `protobuf:"bytes,4,opt,name=Bio,proto3" json:"Bio,omitempty"`
-	Username     string `protobuf:"bytes,5,opt,name=Username,proto3" json:"Username,omitempty"`
-	Phone        string `protobuf:"bytes,6,opt,name=Phone,proto3" json:"Phone,omitempty"`
-	OnlineStatus bool   `protobuf:"varint,7,opt,name=Online_status,json=OnlineStatus,proto3" json:"Online_status,omitempty"`
-	CreatedAt    int64  `protobuf:"varint,8,opt,name=Created_at,json=CreatedAt,proto3" json:"Created_at,omitempty"`
+	Users []*User `protobuf:"bytes,1,rep,name=Users,proto3" json:"Users,omitempty"`
+	// Empty on the last page
+	NextPageToken string        `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	Error         *error1.Error `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,13 +534,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *SearchUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*SearchUsersResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,92 +552,106 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
 	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *User) GetId() string {
+func (x *SearchUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Id
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetName() string {
+func (x *SearchUsersResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *User) GetLastname() string {
+func (x *SearchUsersResponse) GetError() *error1.Error {
 	if x != nil {
-		return x.Lastname
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityCode *SecurityCode `protobuf:"bytes,1,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
 }
 
-func (x *User) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *User) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetOnlineStatus() bool {
-	if x != nil {
-		return x.OnlineStatus
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetCreatedAt() int64 {
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteUserRequest) GetSecurityCode() *SecurityCode {
 	if x != nil {
-		return x.CreatedAt
+		return x.SecurityCode
 	}
-	return 0
+	return nil
 }
 
-type SecurityCode struct {
+type DeleteUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// Unix time that the account will be deleted at if deletion is scheduled. Zero means the account is deleted
+	DeleteAt int64         `protobuf:"varint,1,opt,name=DeleteAt,proto3" json:"DeleteAt,omitempty"`
+	Error    *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *SecurityCode) Reset() {
-	*x = SecurityCode{}
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[9]
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityCode) String() string {
+func (x *DeleteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityCode) ProtoMessage() {}
+func (*DeleteUserResponse) ProtoMessage() {}
 
-func (x *SecurityCode) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[9]
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,207 +662,4458 @@ func (x *SecurityCode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityCode.ProtoReflect.Descriptor instead.
-func (*SecurityCode) Descriptor() ([]byte, []int) {
-	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SecurityCode) GetCode() string {
+func (x *DeleteUserResponse) GetDeleteAt() int64 {
 	if x != nil {
-		return x.Code
+		return x.DeleteAt
 	}
-	return ""
+	return 0
 }
 
-var File_api_pb_UsersService_users_service_proto protoreflect.FileDescriptor
+func (x *DeleteUserResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
 
-var file_api_pb_UsersService_users_service_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x7a, 0x79, 0x74, 0x65, 0x6c,
-	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x1a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
-	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74,
-	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x79,
-	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x6d, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c,
-	0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
-	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x49, 0x0a,
-	0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x42, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42,
-	0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4f, 0x6e, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x32, 0xdf, 0x05, 0x0a,
-	0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a,
-	0x07, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
-	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
-	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
-	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
-	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x56, 0x0a, 0x05,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
-	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x7a,
-	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
-	0x69, 0x67, 0x6e, 0x75, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e,
-	0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x53, 0x0a, 0x18, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
-	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
-	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x61, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
-	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65,
-	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c,
-	0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x7a, 0x79, 0x74,
-	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39,
-	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x79, 0x74,
-	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2f, 0x74, 0x67, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+type RequestDeleteAccountSecurityCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_api_pb_UsersService_users_service_proto_rawDescOnce sync.Once
-	file_api_pb_UsersService_users_service_proto_rawDescData = file_api_pb_UsersService_users_service_proto_rawDesc
-)
+func (x *RequestDeleteAccountSecurityCodeRequest) Reset() {
+	*x = RequestDeleteAccountSecurityCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_pb_UsersService_users_service_proto_rawDescGZIP() []byte {
-	file_api_pb_UsersService_users_service_proto_rawDescOnce.Do(func() {
-		file_api_pb_UsersService_users_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_UsersService_users_service_proto_rawDescData)
-	})
-	return file_api_pb_UsersService_users_service_proto_rawDescData
+func (x *RequestDeleteAccountSecurityCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_pb_UsersService_users_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_api_pb_UsersService_users_service_proto_goTypes = []interface{}{
-	(*GetUserByUsernameRequest)(nil),  // 0: zytell3301.UsersService.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil), // 1: zytell3301.UsersService.GetUserByUsernameResponse
-	(*UpdateUsernameMessage)(nil),     // 2: zytell3301.UsersService.UpdateUsernameMessage
-	(*LoginRequest)(nil),              // 3: zytell3301.UsersService.LoginRequest
-	(*VerifySecurityCodeRequest)(nil), // 4: zytell3301.UsersService.VerifySecurityCodeRequest
-	(*LoginResponse)(nil),             // 5: zytell3301.UsersService.LoginResponse
-	(*NewUserMessage)(nil),            // 6: zytell3301.UsersService.NewUserMessage
-	(*Phone)(nil),                     // 7: zytell3301.UsersService.Phone
-	(*User)(nil),                      // 8: zytell3301.UsersService.User
-	(*SecurityCode)(nil),              // 9: zytell3301.UsersService.SecurityCode
-	(*error1.Error)(nil),              // 10: zytell3301.error.Error
+func (*RequestDeleteAccountSecurityCodeRequest) ProtoMessage() {}
+
+func (x *RequestDeleteAccountSecurityCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_pb_UsersService_users_service_proto_depIdxs = []int32{
-	8,  // 0: zytell3301.UsersService.GetUserByUsernameResponse.User:type_name -> zytell3301.UsersService.User
-	10, // 1: zytell3301.UsersService.GetUserByUsernameResponse.Error:type_name -> zytell3301.error.Error
-	9,  // 2: zytell3301.UsersService.LoginRequest.securityCode:type_name -> zytell3301.UsersService.SecurityCode
-	10, // 3: zytell3301.UsersService.LoginResponse.Error:type_name -> zytell3301.error.Error
-	8,  // 4: zytell3301.UsersService.NewUserMessage.User:type_name -> zytell3301.UsersService.User
-	9,  // 5: zytell3301.UsersService.NewUserMessage.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
-	6,  // 6: zytell3301.UsersService.UsersService.NewUser:input_type -> zytell3301.UsersService.NewUserMessage
-	7,  // 7: zytell3301.UsersService.UsersService.DeleteUser:input_type -> zytell3301.UsersService.Phone
-	2,  // 8: zytell3301.UsersService.UsersService.UpdateUsername:input_type -> zytell3301.UsersService.UpdateUsernameMessage
-	3,  // 9: zytell3301.UsersService.UsersService.Login:input_type -> zytell3301.UsersService.LoginRequest
-	7,  // 10: zytell3301.UsersService.UsersService.RequestSignupSecurityCode:input_type -> zytell3301.UsersService.Phone
-	7,  // 11: zytell3301.UsersService.UsersService.RequestLoginSecurityCode:input_type -> zytell3301.UsersService.Phone
-	4,  // 12: zytell3301.UsersService.UsersService.VerifySecurityCode:input_type -> zytell3301.UsersService.VerifySecurityCodeRequest
-	0,  // 13: zytell3301.UsersService.UsersService.GetUserByUsername:input_type -> zytell3301.UsersService.GetUserByUsernameRequest
-	10, // 14: zytell3301.UsersService.UsersService.NewUser:output_type -> zytell3301.error.Error
-	10, // 15: zytell3301.UsersService.UsersService.DeleteUser:output_type -> zytell3301.error.Error
-	10, // 16: zytell3301.UsersService.UsersService.UpdateUsername:output_type -> zytell3301.error.Error
-	5,  // 17: zytell3301.UsersService.UsersService.Login:output_type -> zytell3301.UsersService.LoginResponse
-	10, // 18: zytell3301.UsersService.UsersService.RequestSignupSecurityCode:output_type -> zytell3301.error.Error
-	10, // 19: zytell3301.UsersService.UsersService.RequestLoginSecurityCode:output_type -> zytell3301.error.Error
-	10, // 20: zytell3301.UsersService.UsersService.VerifySecurityCode:output_type -> zytell3301.error.Error
-	1,  // 21: zytell3301.UsersService.UsersService.GetUserByUsername:output_type -> zytell3301.UsersService.GetUserByUsernameResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use RequestDeleteAccountSecurityCodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestDeleteAccountSecurityCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_api_pb_UsersService_users_service_proto_init() }
-func file_api_pb_UsersService_users_service_proto_init() {
-	if File_api_pb_UsersService_users_service_proto != nil {
-		return
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_pb_UsersService_users_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByUsernameRequest); i {
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{12}
+}
+
+// Days must be one of 30, 90, 180 or 365
+type SetAccountTTLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days int32 `protobuf:"varint,1,opt,name=Days,proto3" json:"Days,omitempty"`
+}
+
+func (x *SetAccountTTLRequest) Reset() {
+	*x = SetAccountTTLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAccountTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountTTLRequest) ProtoMessage() {}
+
+func (x *SetAccountTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountTTLRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetAccountTTLRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetAccountTTLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAccountTTLRequest) Reset() {
+	*x = GetAccountTTLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTTLRequest) ProtoMessage() {}
+
+func (x *GetAccountTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTTLRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountTTLRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{14}
+}
+
+type SetOnlineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOnlineRequest) Reset() {
+	*x = SetOnlineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOnlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOnlineRequest) ProtoMessage() {}
+
+func (x *SetOnlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOnlineRequest.ProtoReflect.Descriptor instead.
+func (*SetOnlineRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{15}
+}
+
+type SetOfflineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOfflineRequest) Reset() {
+	*x = SetOfflineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOfflineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfflineRequest) ProtoMessage() {}
+
+func (x *SetOfflineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfflineRequest.ProtoReflect.Descriptor instead.
+func (*SetOfflineRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{16}
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{17}
+}
+
+// Rule can be PHONE, LAST_SEEN, PROFILE_PHOTO, BIO or FIND_BY_PHONE and visibility can be EVERYBODY, CONTACTS or NOBODY.
+// Visibility applies to users that are in none of the exception lists. Users of DenyUsers can never see the information
+type PrivacyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule       string   `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Visibility string   `protobuf:"bytes,2,opt,name=Visibility,proto3" json:"Visibility,omitempty"`
+	AllowUsers []string `protobuf:"bytes,3,rep,name=AllowUsers,proto3" json:"AllowUsers,omitempty"`
+	DenyUsers  []string `protobuf:"bytes,4,rep,name=DenyUsers,proto3" json:"DenyUsers,omitempty"`
+}
+
+func (x *PrivacyRule) Reset() {
+	*x = PrivacyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivacyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRule) ProtoMessage() {}
+
+func (x *PrivacyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRule.ProtoReflect.Descriptor instead.
+func (*PrivacyRule) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PrivacyRule) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PrivacyRule) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *PrivacyRule) GetAllowUsers() []string {
+	if x != nil {
+		return x.AllowUsers
+	}
+	return nil
+}
+
+func (x *PrivacyRule) GetDenyUsers() []string {
+	if x != nil {
+		return x.DenyUsers
+	}
+	return nil
+}
+
+type SetPrivacyRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *PrivacyRule `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+}
+
+func (x *SetPrivacyRuleRequest) Reset() {
+	*x = SetPrivacyRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPrivacyRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivacyRuleRequest) ProtoMessage() {}
+
+func (x *SetPrivacyRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivacyRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetPrivacyRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetPrivacyRuleRequest) GetRule() *PrivacyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetPrivacyRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPrivacyRulesRequest) Reset() {
+	*x = GetPrivacyRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivacyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyRulesRequest) ProtoMessage() {}
+
+func (x *GetPrivacyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{20}
+}
+
+type GetPrivacyRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*PrivacyRule `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	Error *error1.Error  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetPrivacyRulesResponse) Reset() {
+	*x = GetPrivacyRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivacyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyRulesResponse) ProtoMessage() {}
+
+func (x *GetPrivacyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPrivacyRulesResponse) GetRules() []*PrivacyRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetPrivacyRulesResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ImportedContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone    string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Lastname string `protobuf:"bytes,3,opt,name=Lastname,proto3" json:"Lastname,omitempty"`
+}
+
+func (x *ImportedContact) Reset() {
+	*x = ImportedContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportedContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedContact) ProtoMessage() {}
+
+func (x *ImportedContact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedContact.ProtoReflect.Descriptor instead.
+func (*ImportedContact) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportedContact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ImportedContact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedContact) GetLastname() string {
+	if x != nil {
+		return x.Lastname
+	}
+	return ""
+}
+
+// IsMutual is set if the contact has the owner in its contacts too
+type Contact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Phone    string `protobuf:"bytes,2,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Lastname string `protobuf:"bytes,4,opt,name=Lastname,proto3" json:"Lastname,omitempty"`
+	IsMutual bool   `protobuf:"varint,5,opt,name=IsMutual,proto3" json:"IsMutual,omitempty"`
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Contact) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetLastname() string {
+	if x != nil {
+		return x.Lastname
+	}
+	return ""
+}
+
+func (x *Contact) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
+type ImportContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*ImportedContact `protobuf:"bytes,1,rep,name=Contacts,proto3" json:"Contacts,omitempty"`
+}
+
+func (x *ImportContactsRequest) Reset() {
+	*x = ImportContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsRequest) ProtoMessage() {}
+
+func (x *ImportContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsRequest.ProtoReflect.Descriptor instead.
+func (*ImportContactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportContactsRequest) GetContacts() []*ImportedContact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+// NotFoundPhones are imported phones without a user
+type ImportContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts       []*Contact    `protobuf:"bytes,1,rep,name=Contacts,proto3" json:"Contacts,omitempty"`
+	NotFoundPhones []string      `protobuf:"bytes,2,rep,name=NotFoundPhones,proto3" json:"NotFoundPhones,omitempty"`
+	Error          *error1.Error `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Seconds that caller must wait before importing new phones again. Only set with too many requests error
+	RetryAfter int64 `protobuf:"varint,4,opt,name=RetryAfter,proto3" json:"RetryAfter,omitempty"`
+}
+
+func (x *ImportContactsResponse) Reset() {
+	*x = ImportContactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsResponse) ProtoMessage() {}
+
+func (x *ImportContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsResponse.ProtoReflect.Descriptor instead.
+func (*ImportContactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ImportContactsResponse) GetNotFoundPhones() []string {
+	if x != nil {
+		return x.NotFoundPhones
+	}
+	return nil
+}
+
+func (x *ImportContactsResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ImportContactsResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type GetContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetContactsRequest) Reset() {
+	*x = GetContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsRequest) ProtoMessage() {}
+
+func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetContactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{26}
+}
+
+type GetContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*Contact    `protobuf:"bytes,1,rep,name=Contacts,proto3" json:"Contacts,omitempty"`
+	Hash     string        `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Error    *error1.Error `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetContactsResponse) Reset() {
+	*x = GetContactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsResponse) ProtoMessage() {}
+
+func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetContactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *GetContactsResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetContactsResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []string `protobuf:"bytes,1,rep,name=UserIds,proto3" json:"UserIds,omitempty"`
+}
+
+func (x *DeleteContactsRequest) Reset() {
+	*x = DeleteContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactsRequest) ProtoMessage() {}
+
+func (x *DeleteContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteContactsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetContactsHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetContactsHashRequest) Reset() {
+	*x = GetContactsHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactsHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsHashRequest) ProtoMessage() {}
+
+func (x *GetContactsHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsHashRequest.ProtoReflect.Descriptor instead.
+func (*GetContactsHashRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{29}
+}
+
+type GetContactsHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash  string        `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Error *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetContactsHashResponse) Reset() {
+	*x = GetContactsHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactsHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsHashResponse) ProtoMessage() {}
+
+func (x *GetContactsHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsHashResponse.ProtoReflect.Descriptor instead.
+func (*GetContactsHashResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetContactsHashResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *GetContactsHashResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type SubscribePresenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []string `protobuf:"bytes,1,rep,name=UserIds,proto3" json:"UserIds,omitempty"`
+}
+
+func (x *SubscribePresenceRequest) Reset() {
+	*x = SubscribePresenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePresenceRequest) ProtoMessage() {}
+
+func (x *SubscribePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePresenceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubscribePresenceRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type PresenceUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	OnlineStatus bool   `protobuf:"varint,2,opt,name=OnlineStatus,proto3" json:"OnlineStatus,omitempty"`
+	// Unix time that the user went offline. It is zero for online users or when it is unknown or hidden
+	LastSeen       int64          `protobuf:"varint,3,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+	LastSeenStatus LastSeenStatus `protobuf:"varint,4,opt,name=LastSeenStatus,proto3,enum=zytell3301.UsersService.LastSeenStatus" json:"LastSeenStatus,omitempty"`
+}
+
+func (x *PresenceUpdate) Reset() {
+	*x = PresenceUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdate) ProtoMessage() {}
+
+func (x *PresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdate.ProtoReflect.Descriptor instead.
+func (*PresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PresenceUpdate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PresenceUpdate) GetOnlineStatus() bool {
+	if x != nil {
+		return x.OnlineStatus
+	}
+	return false
+}
+
+func (x *PresenceUpdate) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PresenceUpdate) GetLastSeenStatus() LastSeenStatus {
+	if x != nil {
+		return x.LastSeenStatus
+	}
+	return LastSeenStatus_LAST_SEEN_EXACT
+}
+
+// If Error is set, it is the only message of the stream
+type SubscribePresenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates []*PresenceUpdate `protobuf:"bytes,1,rep,name=Updates,proto3" json:"Updates,omitempty"`
+	Error   *error1.Error     `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *SubscribePresenceResponse) Reset() {
+	*x = SubscribePresenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePresenceResponse) ProtoMessage() {}
+
+func (x *SubscribePresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePresenceResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePresenceResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubscribePresenceResponse) GetUpdates() []*PresenceUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *SubscribePresenceResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAccountTTLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days  int32         `protobuf:"varint,1,opt,name=Days,proto3" json:"Days,omitempty"`
+	Error *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetAccountTTLResponse) Reset() {
+	*x = GetAccountTTLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTTLResponse) ProtoMessage() {}
+
+func (x *GetAccountTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTTLResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountTTLResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAccountTTLResponse) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetAccountTTLResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateUsernameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+}
+
+func (x *UpdateUsernameRequest) Reset() {
+	*x = UpdateUsernameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsernameRequest) ProtoMessage() {}
+
+func (x *UpdateUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsernameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Lastname string `protobuf:"bytes,2,opt,name=Lastname,proto3" json:"Lastname,omitempty"`
+	Bio      string `protobuf:"bytes,3,opt,name=Bio,proto3" json:"Bio,omitempty"`
+	// Fields to update. Paths are case insensitive names of the fields above. Empty mask updates all fields
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLastname() string {
+	if x != nil {
+		return x.Lastname
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ChangePhoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// New phone of the user
+	Phone string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	// Security code that is sent to the new phone
+	SecurityCode *SecurityCode `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+	// Security code that is sent to the current phone
+	CurrentPhoneSecurityCode *SecurityCode `protobuf:"bytes,3,opt,name=CurrentPhoneSecurityCode,proto3" json:"CurrentPhoneSecurityCode,omitempty"`
+}
+
+func (x *ChangePhoneRequest) Reset() {
+	*x = ChangePhoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePhoneRequest) ProtoMessage() {}
+
+func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePhoneRequest.ProtoReflect.Descriptor instead.
+func (*ChangePhoneRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ChangePhoneRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ChangePhoneRequest) GetSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return nil
+}
+
+func (x *ChangePhoneRequest) GetCurrentPhoneSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.CurrentPhoneSecurityCode
+	}
+	return nil
+}
+
+type UpdateUsernameMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone    string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+}
+
+func (x *UpdateUsernameMessage) Reset() {
+	*x = UpdateUsernameMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUsernameMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsernameMessage) ProtoMessage() {}
+
+func (x *UpdateUsernameMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsernameMessage.ProtoReflect.Descriptor instead.
+func (*UpdateUsernameMessage) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateUsernameMessage) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateUsernameMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityCode *SecurityCode `protobuf:"bytes,1,opt,name=securityCode,proto3" json:"securityCode,omitempty"`
+	Phone        string        `protobuf:"bytes,2,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	// PKIX public key of the client in PEM or DER format. Issued certificate certifies this key
+	PublicKey []byte `protobuf:"bytes,3,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	// PKCS#10 certificate signing request of the client in PEM or DER format. If set, PublicKey is ignored
+	CertificateRequest []byte `protobuf:"bytes,4,opt,name=CertificateRequest,proto3" json:"CertificateRequest,omitempty"`
+	// Client name and device description that is shown in active sessions
+	Device string `protobuf:"bytes,5,opt,name=Device,proto3" json:"Device,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LoginRequest) GetSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetCertificateRequest() []byte {
+	if x != nil {
+		return x.CertificateRequest
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type VerifySecurityCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone        string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	SecurityCode string `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+	Action       string `protobuf:"bytes,3,opt,name=Action,proto3" json:"Action,omitempty"`
+}
+
+func (x *VerifySecurityCodeRequest) Reset() {
+	*x = VerifySecurityCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySecurityCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecurityCodeRequest) ProtoMessage() {}
+
+func (x *VerifySecurityCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecurityCodeRequest.ProtoReflect.Descriptor instead.
+func (*VerifySecurityCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifySecurityCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *VerifySecurityCodeRequest) GetSecurityCode() string {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return ""
+}
+
+func (x *VerifySecurityCodeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM encoded certificate chain. Issued certificate is followed by the CA certificate
+	Certificate []byte        `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+	Error       *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Set if two-step verification is enabled. Login must be completed by CheckPassword with LoginToken
+	PasswordRequired bool   `protobuf:"varint,3,opt,name=PasswordRequired,proto3" json:"PasswordRequired,omitempty"`
+	LoginToken       string `protobuf:"bytes,4,opt,name=LoginToken,proto3" json:"LoginToken,omitempty"`
+	PasswordHint     string `protobuf:"bytes,5,opt,name=PasswordHint,proto3" json:"PasswordHint,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LoginResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetPasswordRequired() bool {
+	if x != nil {
+		return x.PasswordRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetLoginToken() string {
+	if x != nil {
+		return x.LoginToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetPasswordHint() string {
+	if x != nil {
+		return x.PasswordHint
+	}
+	return ""
+}
+
+type CheckPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginToken string `protobuf:"bytes,1,opt,name=LoginToken,proto3" json:"LoginToken,omitempty"`
+	Password   string `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *CheckPasswordRequest) Reset() {
+	*x = CheckPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPasswordRequest) ProtoMessage() {}
+
+func (x *CheckPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPasswordRequest.ProtoReflect.Descriptor instead.
+func (*CheckPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CheckPasswordRequest) GetLoginToken() string {
+	if x != nil {
+		return x.LoginToken
+	}
+	return ""
+}
+
+func (x *CheckPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Hint and RecoveryEmail are optional
+type SetPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password      string `protobuf:"bytes,1,opt,name=Password,proto3" json:"Password,omitempty"`
+	Hint          string `protobuf:"bytes,2,opt,name=Hint,proto3" json:"Hint,omitempty"`
+	RecoveryEmail string `protobuf:"bytes,3,opt,name=RecoveryEmail,proto3" json:"RecoveryEmail,omitempty"`
+}
+
+func (x *SetPasswordRequest) Reset() {
+	*x = SetPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPasswordRequest) ProtoMessage() {}
+
+func (x *SetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*SetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetPasswordRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *SetPasswordRequest) GetRecoveryEmail() string {
+	if x != nil {
+		return x.RecoveryEmail
+	}
+	return ""
+}
+
+// Hint and RecoveryEmail replace the current ones
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPassword string `protobuf:"bytes,1,opt,name=CurrentPassword,proto3" json:"CurrentPassword,omitempty"`
+	NewPassword     string `protobuf:"bytes,2,opt,name=NewPassword,proto3" json:"NewPassword,omitempty"`
+	Hint            string `protobuf:"bytes,3,opt,name=Hint,proto3" json:"Hint,omitempty"`
+	RecoveryEmail   string `protobuf:"bytes,4,opt,name=RecoveryEmail,proto3" json:"RecoveryEmail,omitempty"`
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetRecoveryEmail() string {
+	if x != nil {
+		return x.RecoveryEmail
+	}
+	return ""
+}
+
+type DisablePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentPassword string `protobuf:"bytes,1,opt,name=CurrentPassword,proto3" json:"CurrentPassword,omitempty"`
+}
+
+func (x *DisablePasswordRequest) Reset() {
+	*x = DisablePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisablePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePasswordRequest) ProtoMessage() {}
+
+func (x *DisablePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePasswordRequest.ProtoReflect.Descriptor instead.
+func (*DisablePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DisablePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type RequestRecoveryEmailCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RequestRecoveryEmailCodeRequest) Reset() {
+	*x = RequestRecoveryEmailCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestRecoveryEmailCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRecoveryEmailCodeRequest) ProtoMessage() {}
+
+func (x *RequestRecoveryEmailCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRecoveryEmailCodeRequest.ProtoReflect.Descriptor instead.
+func (*RequestRecoveryEmailCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{46}
+}
+
+type VerifyRecoveryEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityCode *SecurityCode `protobuf:"bytes,1,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+}
+
+func (x *VerifyRecoveryEmailRequest) Reset() {
+	*x = VerifyRecoveryEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyRecoveryEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRecoveryEmailRequest) ProtoMessage() {}
+
+func (x *VerifyRecoveryEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRecoveryEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRecoveryEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VerifyRecoveryEmailRequest) GetSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return nil
+}
+
+type RequestPasswordRecoveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginToken string `protobuf:"bytes,1,opt,name=LoginToken,proto3" json:"LoginToken,omitempty"`
+}
+
+func (x *RequestPasswordRecoveryRequest) Reset() {
+	*x = RequestPasswordRecoveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordRecoveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordRecoveryRequest) ProtoMessage() {}
+
+func (x *RequestPasswordRecoveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordRecoveryRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordRecoveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RequestPasswordRecoveryRequest) GetLoginToken() string {
+	if x != nil {
+		return x.LoginToken
+	}
+	return ""
+}
+
+type RequestPasswordRecoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Masked recovery email that the code is sent to
+	EmailPattern string        `protobuf:"bytes,1,opt,name=EmailPattern,proto3" json:"EmailPattern,omitempty"`
+	Error        *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Seconds that caller must wait before requesting again. Only set with too many requests error
+	RetryAfter int64 `protobuf:"varint,3,opt,name=RetryAfter,proto3" json:"RetryAfter,omitempty"`
+}
+
+func (x *RequestPasswordRecoveryResponse) Reset() {
+	*x = RequestPasswordRecoveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordRecoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordRecoveryResponse) ProtoMessage() {}
+
+func (x *RequestPasswordRecoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordRecoveryResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordRecoveryResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RequestPasswordRecoveryResponse) GetEmailPattern() string {
+	if x != nil {
+		return x.EmailPattern
+	}
+	return ""
+}
+
+func (x *RequestPasswordRecoveryResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RequestPasswordRecoveryResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+// Empty NewPassword disables two-step verification
+type RecoverPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginToken   string        `protobuf:"bytes,1,opt,name=LoginToken,proto3" json:"LoginToken,omitempty"`
+	SecurityCode *SecurityCode `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+	NewPassword  string        `protobuf:"bytes,3,opt,name=NewPassword,proto3" json:"NewPassword,omitempty"`
+	Hint         string        `protobuf:"bytes,4,opt,name=Hint,proto3" json:"Hint,omitempty"`
+}
+
+func (x *RecoverPasswordRequest) Reset() {
+	*x = RecoverPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverPasswordRequest) ProtoMessage() {}
+
+func (x *RecoverPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverPasswordRequest.ProtoReflect.Descriptor instead.
+func (*RecoverPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecoverPasswordRequest) GetLoginToken() string {
+	if x != nil {
+		return x.LoginToken
+	}
+	return ""
+}
+
+func (x *RecoverPasswordRequest) GetSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return nil
+}
+
+func (x *RecoverPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *RecoverPasswordRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+type NewUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User         *User         `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	SecurityCode *SecurityCode `protobuf:"bytes,2,opt,name=SecurityCode,proto3" json:"SecurityCode,omitempty"`
+}
+
+func (x *NewUserMessage) Reset() {
+	*x = NewUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewUserMessage) ProtoMessage() {}
+
+func (x *NewUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewUserMessage.ProtoReflect.Descriptor instead.
+func (*NewUserMessage) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *NewUserMessage) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *NewUserMessage) GetSecurityCode() *SecurityCode {
+	if x != nil {
+		return x.SecurityCode
+	}
+	return nil
+}
+
+type Phone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=Phone,proto3" json:"Phone,omitempty"`
+}
+
+func (x *Phone) Reset() {
+	*x = Phone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Phone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phone) ProtoMessage() {}
+
+func (x *Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
+func (*Phone) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Phone) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Lastname     string `protobuf:"bytes,3,opt,name=Lastname,proto3" json:"Lastname,omitempty"`
+	Bio          string `protobuf:"bytes,4,opt,name=Bio,proto3" json:"Bio,omitempty"`
+	Username     string `protobuf:"bytes,5,opt,name=Username,proto3" json:"Username,omitempty"`
+	Phone        string `protobuf:"bytes,6,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	OnlineStatus bool   `protobuf:"varint,7,opt,name=Online_status,json=OnlineStatus,proto3" json:"Online_status,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,8,opt,name=Created_at,json=CreatedAt,proto3" json:"Created_at,omitempty"`
+	// Unix time of the last time that the user was online. It is zero when it is unknown or hidden
+	LastSeen       int64          `protobuf:"varint,9,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+	LastSeenStatus LastSeenStatus `protobuf:"varint,10,opt,name=LastSeenStatus,proto3,enum=zytell3301.UsersService.LastSeenStatus" json:"LastSeenStatus,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetLastname() string {
+	if x != nil {
+		return x.Lastname
+	}
+	return ""
+}
+
+func (x *User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *User) GetOnlineStatus() bool {
+	if x != nil {
+		return x.OnlineStatus
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *User) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *User) GetLastSeenStatus() LastSeenStatus {
+	if x != nil {
+		return x.LastSeenStatus
+	}
+	return LastSeenStatus_LAST_SEEN_EXACT
+}
+
+type SecurityCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+}
+
+func (x *SecurityCode) Reset() {
+	*x = SecurityCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityCode) ProtoMessage() {}
+
+func (x *SecurityCode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityCode.ProtoReflect.Descriptor instead.
+func (*SecurityCode) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SecurityCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RequestSecurityCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *error1.Error `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Seconds that client must wait before requesting a new security code. Only set when too many requests are sent
+	RetryAfter int64 `protobuf:"varint,2,opt,name=RetryAfter,proto3" json:"RetryAfter,omitempty"`
+}
+
+func (x *RequestSecurityCodeResponse) Reset() {
+	*x = RequestSecurityCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestSecurityCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSecurityCodeResponse) ProtoMessage() {}
+
+func (x *RequestSecurityCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSecurityCodeResponse.ProtoReflect.Descriptor instead.
+func (*RequestSecurityCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RequestSecurityCodeResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RequestSecurityCodeResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{56}
+}
+
+type RevokeCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// Decimal serial number of the certificate
+	Serial string `protobuf:"bytes,2,opt,name=Serial,proto3" json:"Serial,omitempty"`
+}
+
+func (x *RevokeCertificateRequest) Reset() {
+	*x = RevokeCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RevokeCertificateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeCertificateRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type IsCertificateRevokedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Decimal serial number of the certificate
+	Serial string `protobuf:"bytes,1,opt,name=Serial,proto3" json:"Serial,omitempty"`
+}
+
+func (x *IsCertificateRevokedRequest) Reset() {
+	*x = IsCertificateRevokedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsCertificateRevokedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsCertificateRevokedRequest) ProtoMessage() {}
+
+func (x *IsCertificateRevokedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsCertificateRevokedRequest.ProtoReflect.Descriptor instead.
+func (*IsCertificateRevokedRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *IsCertificateRevokedRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type IsCertificateRevokedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revoked bool          `protobuf:"varint,1,opt,name=Revoked,proto3" json:"Revoked,omitempty"`
+	Error   *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *IsCertificateRevokedResponse) Reset() {
+	*x = IsCertificateRevokedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsCertificateRevokedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsCertificateRevokedResponse) ProtoMessage() {}
+
+func (x *IsCertificateRevokedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsCertificateRevokedResponse.ProtoReflect.Descriptor instead.
+func (*IsCertificateRevokedResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *IsCertificateRevokedResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *IsCertificateRevokedResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetCertificateRevocationListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCertificateRevocationListRequest) Reset() {
+	*x = GetCertificateRevocationListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCertificateRevocationListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateRevocationListRequest) ProtoMessage() {}
+
+func (x *GetCertificateRevocationListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateRevocationListRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificateRevocationListRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{60}
+}
+
+type GetCertificateRevocationListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DER encoded certificate revocation list signed by service CA
+	RevocationList []byte        `protobuf:"bytes,1,opt,name=RevocationList,proto3" json:"RevocationList,omitempty"`
+	Error          *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetCertificateRevocationListResponse) Reset() {
+	*x = GetCertificateRevocationListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCertificateRevocationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateRevocationListResponse) ProtoMessage() {}
+
+func (x *GetCertificateRevocationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateRevocationListResponse.ProtoReflect.Descriptor instead.
+func (*GetCertificateRevocationListResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCertificateRevocationListResponse) GetRevocationList() []byte {
+	if x != nil {
+		return x.RevocationList
+	}
+	return nil
+}
+
+func (x *GetCertificateRevocationListResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Decimal serial number of the certificate of the session
+	Serial    string `protobuf:"bytes,1,opt,name=Serial,proto3" json:"Serial,omitempty"`
+	Device    string `protobuf:"bytes,2,opt,name=Device,proto3" json:"Device,omitempty"`
+	Ip        string `protobuf:"bytes,3,opt,name=Ip,proto3" json:"Ip,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Session) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{63}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session    `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+	Error    *error1.Error `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetError() *error1.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type TerminateSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serial of the session that must be terminated
+	Serial string `protobuf:"bytes,1,opt,name=Serial,proto3" json:"Serial,omitempty"`
+}
+
+func (x *TerminateSessionRequest) Reset() {
+	*x = TerminateSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateSessionRequest) ProtoMessage() {}
+
+func (x *TerminateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateSessionRequest.ProtoReflect.Descriptor instead.
+func (*TerminateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TerminateSessionRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type TerminateAllOtherSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TerminateAllOtherSessionsRequest) Reset() {
+	*x = TerminateAllOtherSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_UsersService_users_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateAllOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateAllOtherSessionsRequest) ProtoMessage() {}
+
+func (x *TerminateAllOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_UsersService_users_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateAllOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*TerminateAllOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_UsersService_users_service_proto_rawDescGZIP(), []int{66}
+}
+
+var File_api_pb_UsersService_users_service_proto protoreflect.FileDescriptor
+
+var file_api_pb_UsersService_users_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x1a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x64, 0x22, 0x73, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c,
+	0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x64,
+	0x73, 0x22, 0x6b, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x8b,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x12,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9f, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5f,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x29, 0x0a, 0x27, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x44, 0x61, 0x79, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0b, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x44, 0x65, 0x6e, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x44, 0x65, 0x6e, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x22,
+	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x57, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x22,
+	0x5d, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0xcd,
+	0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x14,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xb9,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x19, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x42, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x42, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12,
+	0x49, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
+	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x61, 0x0a, 0x18, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x18, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x49, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x22, 0x6d, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xd0, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x69, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x69,
+	0x6e, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x6a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e, 0x65, 0x77,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x42, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x1a, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x40, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2d, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x42, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42,
+	0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x6c, 0x0a, 0x1b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c,
+	0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x1b, 0x49, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x67, 0x0a, 0x1c, 0x49,
+	0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30,
+	0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x24, 0x47,
+	0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x52, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x07, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x31, 0x0a, 0x17, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x22, 0x22, 0x0a, 0x20, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x41,
+	0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0x8c, 0x01, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x41, 0x53,
+	0x54, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x4e, 0x54, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53,
+	0x45, 0x45, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x45, 0x4e, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x5f,
+	0x41, 0x47, 0x4f, 0x10, 0x04, 0x32, 0xd7, 0x25, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x27, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x65, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x2a, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x56, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x25,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
+	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2d,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x37, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c,
+	0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x71, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x75,
+	0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x34, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a,
+	0x34, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x2e, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x79, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x28, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5f, 0x0a, 0x11, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x31, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x83, 0x01, 0x0a,
+	0x14, 0x49, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x34, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
+	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x49, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x3c, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2c, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
+	0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x6f, 0x0a, 0x19,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c,
+	0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30,
+	0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x57, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x76, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x34, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2b, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x9a, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x12, 0x2d, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x54, 0x4c, 0x12, 0x2d, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2b, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
+	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x8a, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x13,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x33, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x4f, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29,
+	0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x51, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x2a, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a,
+	0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x29, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c,
+	0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x74, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33,
+	0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x7a, 0x79, 0x74, 0x65,
+	0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x74, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x2f, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x7a, 0x79, 0x74,
+	0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x5e, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e, 0x7a, 0x79, 0x74, 0x65, 0x6c, 0x6c, 0x33,
+	0x33, 0x30, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x79,
+	0x74, 0x65, 0x6c, 0x6c, 0x33, 0x33, 0x30, 0x31, 0x2f, 0x74, 0x67, 0x2d, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_api_pb_UsersService_users_service_proto_rawDescOnce sync.Once
+	file_api_pb_UsersService_users_service_proto_rawDescData = file_api_pb_UsersService_users_service_proto_rawDesc
+)
+
+func file_api_pb_UsersService_users_service_proto_rawDescGZIP() []byte {
+	file_api_pb_UsersService_users_service_proto_rawDescOnce.Do(func() {
+		file_api_pb_UsersService_users_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_UsersService_users_service_proto_rawDescData)
+	})
+	return file_api_pb_UsersService_users_service_proto_rawDescData
+}
+
+var file_api_pb_UsersService_users_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_pb_UsersService_users_service_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_api_pb_UsersService_users_service_proto_goTypes = []interface{}{
+	(LastSeenStatus)(0),                             // 0: zytell3301.UsersService.LastSeenStatus
+	(*GetUserByUsernameRequest)(nil),                // 1: zytell3301.UsersService.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil),               // 2: zytell3301.UsersService.GetUserByUsernameResponse
+	(*GetUserByIdRequest)(nil),                      // 3: zytell3301.UsersService.GetUserByIdRequest
+	(*GetUserResponse)(nil),                         // 4: zytell3301.UsersService.GetUserResponse
+	(*GetUsersByIdsRequest)(nil),                    // 5: zytell3301.UsersService.GetUsersByIdsRequest
+	(*UserLookupResult)(nil),                        // 6: zytell3301.UsersService.UserLookupResult
+	(*GetUsersByIdsResponse)(nil),                   // 7: zytell3301.UsersService.GetUsersByIdsResponse
+	(*SearchUsersRequest)(nil),                      // 8: zytell3301.UsersService.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                     // 9: zytell3301.UsersService.SearchUsersResponse
+	(*DeleteUserRequest)(nil),                       // 10: zytell3301.UsersService.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                      // 11: zytell3301.UsersService.DeleteUserResponse
+	(*RequestDeleteAccountSecurityCodeRequest)(nil), // 12: zytell3301.UsersService.RequestDeleteAccountSecurityCodeRequest
+	(*CancelAccountDeletionRequest)(nil),            // 13: zytell3301.UsersService.CancelAccountDeletionRequest
+	(*SetAccountTTLRequest)(nil),                    // 14: zytell3301.UsersService.SetAccountTTLRequest
+	(*GetAccountTTLRequest)(nil),                    // 15: zytell3301.UsersService.GetAccountTTLRequest
+	(*SetOnlineRequest)(nil),                        // 16: zytell3301.UsersService.SetOnlineRequest
+	(*SetOfflineRequest)(nil),                       // 17: zytell3301.UsersService.SetOfflineRequest
+	(*HeartbeatRequest)(nil),                        // 18: zytell3301.UsersService.HeartbeatRequest
+	(*PrivacyRule)(nil),                             // 19: zytell3301.UsersService.PrivacyRule
+	(*SetPrivacyRuleRequest)(nil),                   // 20: zytell3301.UsersService.SetPrivacyRuleRequest
+	(*GetPrivacyRulesRequest)(nil),                  // 21: zytell3301.UsersService.GetPrivacyRulesRequest
+	(*GetPrivacyRulesResponse)(nil),                 // 22: zytell3301.UsersService.GetPrivacyRulesResponse
+	(*ImportedContact)(nil),                         // 23: zytell3301.UsersService.ImportedContact
+	(*Contact)(nil),                                 // 24: zytell3301.UsersService.Contact
+	(*ImportContactsRequest)(nil),                   // 25: zytell3301.UsersService.ImportContactsRequest
+	(*ImportContactsResponse)(nil),                  // 26: zytell3301.UsersService.ImportContactsResponse
+	(*GetContactsRequest)(nil),                      // 27: zytell3301.UsersService.GetContactsRequest
+	(*GetContactsResponse)(nil),                     // 28: zytell3301.UsersService.GetContactsResponse
+	(*DeleteContactsRequest)(nil),                   // 29: zytell3301.UsersService.DeleteContactsRequest
+	(*GetContactsHashRequest)(nil),                  // 30: zytell3301.UsersService.GetContactsHashRequest
+	(*GetContactsHashResponse)(nil),                 // 31: zytell3301.UsersService.GetContactsHashResponse
+	(*SubscribePresenceRequest)(nil),                // 32: zytell3301.UsersService.SubscribePresenceRequest
+	(*PresenceUpdate)(nil),                          // 33: zytell3301.UsersService.PresenceUpdate
+	(*SubscribePresenceResponse)(nil),               // 34: zytell3301.UsersService.SubscribePresenceResponse
+	(*GetAccountTTLResponse)(nil),                   // 35: zytell3301.UsersService.GetAccountTTLResponse
+	(*UpdateUsernameRequest)(nil),                   // 36: zytell3301.UsersService.UpdateUsernameRequest
+	(*UpdateProfileRequest)(nil),                    // 37: zytell3301.UsersService.UpdateProfileRequest
+	(*ChangePhoneRequest)(nil),                      // 38: zytell3301.UsersService.ChangePhoneRequest
+	(*UpdateUsernameMessage)(nil),                   // 39: zytell3301.UsersService.UpdateUsernameMessage
+	(*LoginRequest)(nil),                            // 40: zytell3301.UsersService.LoginRequest
+	(*VerifySecurityCodeRequest)(nil),               // 41: zytell3301.UsersService.VerifySecurityCodeRequest
+	(*LoginResponse)(nil),                           // 42: zytell3301.UsersService.LoginResponse
+	(*CheckPasswordRequest)(nil),                    // 43: zytell3301.UsersService.CheckPasswordRequest
+	(*SetPasswordRequest)(nil),                      // 44: zytell3301.UsersService.SetPasswordRequest
+	(*ChangePasswordRequest)(nil),                   // 45: zytell3301.UsersService.ChangePasswordRequest
+	(*DisablePasswordRequest)(nil),                  // 46: zytell3301.UsersService.DisablePasswordRequest
+	(*RequestRecoveryEmailCodeRequest)(nil),         // 47: zytell3301.UsersService.RequestRecoveryEmailCodeRequest
+	(*VerifyRecoveryEmailRequest)(nil),              // 48: zytell3301.UsersService.VerifyRecoveryEmailRequest
+	(*RequestPasswordRecoveryRequest)(nil),          // 49: zytell3301.UsersService.RequestPasswordRecoveryRequest
+	(*RequestPasswordRecoveryResponse)(nil),         // 50: zytell3301.UsersService.RequestPasswordRecoveryResponse
+	(*RecoverPasswordRequest)(nil),                  // 51: zytell3301.UsersService.RecoverPasswordRequest
+	(*NewUserMessage)(nil),                          // 52: zytell3301.UsersService.NewUserMessage
+	(*Phone)(nil),                                   // 53: zytell3301.UsersService.Phone
+	(*User)(nil),                                    // 54: zytell3301.UsersService.User
+	(*SecurityCode)(nil),                            // 55: zytell3301.UsersService.SecurityCode
+	(*RequestSecurityCodeResponse)(nil),             // 56: zytell3301.UsersService.RequestSecurityCodeResponse
+	(*LogoutRequest)(nil),                           // 57: zytell3301.UsersService.LogoutRequest
+	(*RevokeCertificateRequest)(nil),                // 58: zytell3301.UsersService.RevokeCertificateRequest
+	(*IsCertificateRevokedRequest)(nil),             // 59: zytell3301.UsersService.IsCertificateRevokedRequest
+	(*IsCertificateRevokedResponse)(nil),            // 60: zytell3301.UsersService.IsCertificateRevokedResponse
+	(*GetCertificateRevocationListRequest)(nil),     // 61: zytell3301.UsersService.GetCertificateRevocationListRequest
+	(*GetCertificateRevocationListResponse)(nil),    // 62: zytell3301.UsersService.GetCertificateRevocationListResponse
+	(*Session)(nil),                                 // 63: zytell3301.UsersService.Session
+	(*ListSessionsRequest)(nil),                     // 64: zytell3301.UsersService.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                    // 65: zytell3301.UsersService.ListSessionsResponse
+	(*TerminateSessionRequest)(nil),                 // 66: zytell3301.UsersService.TerminateSessionRequest
+	(*TerminateAllOtherSessionsRequest)(nil),        // 67: zytell3301.UsersService.TerminateAllOtherSessionsRequest
+	(*error1.Error)(nil),                            // 68: zytell3301.error.Error
+	(*fieldmaskpb.FieldMask)(nil),                   // 69: google.protobuf.FieldMask
+}
+var file_api_pb_UsersService_users_service_proto_depIdxs = []int32{
+	54, // 0: zytell3301.UsersService.GetUserByUsernameResponse.User:type_name -> zytell3301.UsersService.User
+	68, // 1: zytell3301.UsersService.GetUserByUsernameResponse.Error:type_name -> zytell3301.error.Error
+	54, // 2: zytell3301.UsersService.GetUserResponse.User:type_name -> zytell3301.UsersService.User
+	68, // 3: zytell3301.UsersService.GetUserResponse.Error:type_name -> zytell3301.error.Error
+	54, // 4: zytell3301.UsersService.UserLookupResult.User:type_name -> zytell3301.UsersService.User
+	6,  // 5: zytell3301.UsersService.GetUsersByIdsResponse.Results:type_name -> zytell3301.UsersService.UserLookupResult
+	68, // 6: zytell3301.UsersService.GetUsersByIdsResponse.Error:type_name -> zytell3301.error.Error
+	54, // 7: zytell3301.UsersService.SearchUsersResponse.Users:type_name -> zytell3301.UsersService.User
+	68, // 8: zytell3301.UsersService.SearchUsersResponse.Error:type_name -> zytell3301.error.Error
+	55, // 9: zytell3301.UsersService.DeleteUserRequest.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	68, // 10: zytell3301.UsersService.DeleteUserResponse.Error:type_name -> zytell3301.error.Error
+	19, // 11: zytell3301.UsersService.SetPrivacyRuleRequest.Rule:type_name -> zytell3301.UsersService.PrivacyRule
+	19, // 12: zytell3301.UsersService.GetPrivacyRulesResponse.Rules:type_name -> zytell3301.UsersService.PrivacyRule
+	68, // 13: zytell3301.UsersService.GetPrivacyRulesResponse.Error:type_name -> zytell3301.error.Error
+	23, // 14: zytell3301.UsersService.ImportContactsRequest.Contacts:type_name -> zytell3301.UsersService.ImportedContact
+	24, // 15: zytell3301.UsersService.ImportContactsResponse.Contacts:type_name -> zytell3301.UsersService.Contact
+	68, // 16: zytell3301.UsersService.ImportContactsResponse.Error:type_name -> zytell3301.error.Error
+	24, // 17: zytell3301.UsersService.GetContactsResponse.Contacts:type_name -> zytell3301.UsersService.Contact
+	68, // 18: zytell3301.UsersService.GetContactsResponse.Error:type_name -> zytell3301.error.Error
+	68, // 19: zytell3301.UsersService.GetContactsHashResponse.Error:type_name -> zytell3301.error.Error
+	0,  // 20: zytell3301.UsersService.PresenceUpdate.LastSeenStatus:type_name -> zytell3301.UsersService.LastSeenStatus
+	33, // 21: zytell3301.UsersService.SubscribePresenceResponse.Updates:type_name -> zytell3301.UsersService.PresenceUpdate
+	68, // 22: zytell3301.UsersService.SubscribePresenceResponse.Error:type_name -> zytell3301.error.Error
+	68, // 23: zytell3301.UsersService.GetAccountTTLResponse.Error:type_name -> zytell3301.error.Error
+	69, // 24: zytell3301.UsersService.UpdateProfileRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	55, // 25: zytell3301.UsersService.ChangePhoneRequest.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	55, // 26: zytell3301.UsersService.ChangePhoneRequest.CurrentPhoneSecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	55, // 27: zytell3301.UsersService.LoginRequest.securityCode:type_name -> zytell3301.UsersService.SecurityCode
+	68, // 28: zytell3301.UsersService.LoginResponse.Error:type_name -> zytell3301.error.Error
+	55, // 29: zytell3301.UsersService.VerifyRecoveryEmailRequest.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	68, // 30: zytell3301.UsersService.RequestPasswordRecoveryResponse.Error:type_name -> zytell3301.error.Error
+	55, // 31: zytell3301.UsersService.RecoverPasswordRequest.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	54, // 32: zytell3301.UsersService.NewUserMessage.User:type_name -> zytell3301.UsersService.User
+	55, // 33: zytell3301.UsersService.NewUserMessage.SecurityCode:type_name -> zytell3301.UsersService.SecurityCode
+	0,  // 34: zytell3301.UsersService.User.LastSeenStatus:type_name -> zytell3301.UsersService.LastSeenStatus
+	68, // 35: zytell3301.UsersService.RequestSecurityCodeResponse.Error:type_name -> zytell3301.error.Error
+	68, // 36: zytell3301.UsersService.IsCertificateRevokedResponse.Error:type_name -> zytell3301.error.Error
+	68, // 37: zytell3301.UsersService.GetCertificateRevocationListResponse.Error:type_name -> zytell3301.error.Error
+	63, // 38: zytell3301.UsersService.ListSessionsResponse.Sessions:type_name -> zytell3301.UsersService.Session
+	68, // 39: zytell3301.UsersService.ListSessionsResponse.Error:type_name -> zytell3301.error.Error
+	52, // 40: zytell3301.UsersService.UsersService.NewUser:input_type -> zytell3301.UsersService.NewUserMessage
+	10, // 41: zytell3301.UsersService.UsersService.DeleteUser:input_type -> zytell3301.UsersService.DeleteUserRequest
+	36, // 42: zytell3301.UsersService.UsersService.UpdateUsername:input_type -> zytell3301.UsersService.UpdateUsernameRequest
+	40, // 43: zytell3301.UsersService.UsersService.Login:input_type -> zytell3301.UsersService.LoginRequest
+	43, // 44: zytell3301.UsersService.UsersService.CheckPassword:input_type -> zytell3301.UsersService.CheckPasswordRequest
+	49, // 45: zytell3301.UsersService.UsersService.RequestPasswordRecovery:input_type -> zytell3301.UsersService.RequestPasswordRecoveryRequest
+	51, // 46: zytell3301.UsersService.UsersService.RecoverPassword:input_type -> zytell3301.UsersService.RecoverPasswordRequest
+	53, // 47: zytell3301.UsersService.UsersService.RequestSignupSecurityCode:input_type -> zytell3301.UsersService.Phone
+	53, // 48: zytell3301.UsersService.UsersService.RequestLoginSecurityCode:input_type -> zytell3301.UsersService.Phone
+	41, // 49: zytell3301.UsersService.UsersService.VerifySecurityCode:input_type -> zytell3301.UsersService.VerifySecurityCodeRequest
+	1,  // 50: zytell3301.UsersService.UsersService.GetUserByUsername:input_type -> zytell3301.UsersService.GetUserByUsernameRequest
+	3,  // 51: zytell3301.UsersService.UsersService.GetUserById:input_type -> zytell3301.UsersService.GetUserByIdRequest
+	5,  // 52: zytell3301.UsersService.UsersService.GetUsersByIds:input_type -> zytell3301.UsersService.GetUsersByIdsRequest
+	8,  // 53: zytell3301.UsersService.UsersService.SearchUsers:input_type -> zytell3301.UsersService.SearchUsersRequest
+	53, // 54: zytell3301.UsersService.UsersService.GetUserByPhone:input_type -> zytell3301.UsersService.Phone
+	57, // 55: zytell3301.UsersService.UsersService.Logout:input_type -> zytell3301.UsersService.LogoutRequest
+	58, // 56: zytell3301.UsersService.UsersService.RevokeCertificate:input_type -> zytell3301.UsersService.RevokeCertificateRequest
+	59, // 57: zytell3301.UsersService.UsersService.IsCertificateRevoked:input_type -> zytell3301.UsersService.IsCertificateRevokedRequest
+	61, // 58: zytell3301.UsersService.UsersService.GetCertificateRevocationList:input_type -> zytell3301.UsersService.GetCertificateRevocationListRequest
+	64, // 59: zytell3301.UsersService.UsersService.ListSessions:input_type -> zytell3301.UsersService.ListSessionsRequest
+	66, // 60: zytell3301.UsersService.UsersService.TerminateSession:input_type -> zytell3301.UsersService.TerminateSessionRequest
+	67, // 61: zytell3301.UsersService.UsersService.TerminateAllOtherSessions:input_type -> zytell3301.UsersService.TerminateAllOtherSessionsRequest
+	37, // 62: zytell3301.UsersService.UsersService.UpdateProfile:input_type -> zytell3301.UsersService.UpdateProfileRequest
+	53, // 63: zytell3301.UsersService.UsersService.RequestChangePhoneSecurityCode:input_type -> zytell3301.UsersService.Phone
+	38, // 64: zytell3301.UsersService.UsersService.ChangePhone:input_type -> zytell3301.UsersService.ChangePhoneRequest
+	12, // 65: zytell3301.UsersService.UsersService.RequestDeleteAccountSecurityCode:input_type -> zytell3301.UsersService.RequestDeleteAccountSecurityCodeRequest
+	13, // 66: zytell3301.UsersService.UsersService.CancelAccountDeletion:input_type -> zytell3301.UsersService.CancelAccountDeletionRequest
+	14, // 67: zytell3301.UsersService.UsersService.SetAccountTTL:input_type -> zytell3301.UsersService.SetAccountTTLRequest
+	15, // 68: zytell3301.UsersService.UsersService.GetAccountTTL:input_type -> zytell3301.UsersService.GetAccountTTLRequest
+	44, // 69: zytell3301.UsersService.UsersService.SetPassword:input_type -> zytell3301.UsersService.SetPasswordRequest
+	45, // 70: zytell3301.UsersService.UsersService.ChangePassword:input_type -> zytell3301.UsersService.ChangePasswordRequest
+	46, // 71: zytell3301.UsersService.UsersService.DisablePassword:input_type -> zytell3301.UsersService.DisablePasswordRequest
+	47, // 72: zytell3301.UsersService.UsersService.RequestRecoveryEmailCode:input_type -> zytell3301.UsersService.RequestRecoveryEmailCodeRequest
+	48, // 73: zytell3301.UsersService.UsersService.VerifyRecoveryEmail:input_type -> zytell3301.UsersService.VerifyRecoveryEmailRequest
+	16, // 74: zytell3301.UsersService.UsersService.SetOnline:input_type -> zytell3301.UsersService.SetOnlineRequest
+	17, // 75: zytell3301.UsersService.UsersService.SetOffline:input_type -> zytell3301.UsersService.SetOfflineRequest
+	18, // 76: zytell3301.UsersService.UsersService.Heartbeat:input_type -> zytell3301.UsersService.HeartbeatRequest
+	20, // 77: zytell3301.UsersService.UsersService.SetPrivacyRule:input_type -> zytell3301.UsersService.SetPrivacyRuleRequest
+	21, // 78: zytell3301.UsersService.UsersService.GetPrivacyRules:input_type -> zytell3301.UsersService.GetPrivacyRulesRequest
+	25, // 79: zytell3301.UsersService.UsersService.ImportContacts:input_type -> zytell3301.UsersService.ImportContactsRequest
+	27, // 80: zytell3301.UsersService.UsersService.GetContacts:input_type -> zytell3301.UsersService.GetContactsRequest
+	29, // 81: zytell3301.UsersService.UsersService.DeleteContacts:input_type -> zytell3301.UsersService.DeleteContactsRequest
+	30, // 82: zytell3301.UsersService.UsersService.GetContactsHash:input_type -> zytell3301.UsersService.GetContactsHashRequest
+	32, // 83: zytell3301.UsersService.UsersService.SubscribePresence:input_type -> zytell3301.UsersService.SubscribePresenceRequest
+	53, // 84: zytell3301.UsersService.UsersService.AdminDeleteUser:input_type -> zytell3301.UsersService.Phone
+	39, // 85: zytell3301.UsersService.UsersService.AdminUpdateUsername:input_type -> zytell3301.UsersService.UpdateUsernameMessage
+	68, // 86: zytell3301.UsersService.UsersService.NewUser:output_type -> zytell3301.error.Error
+	11, // 87: zytell3301.UsersService.UsersService.DeleteUser:output_type -> zytell3301.UsersService.DeleteUserResponse
+	68, // 88: zytell3301.UsersService.UsersService.UpdateUsername:output_type -> zytell3301.error.Error
+	42, // 89: zytell3301.UsersService.UsersService.Login:output_type -> zytell3301.UsersService.LoginResponse
+	42, // 90: zytell3301.UsersService.UsersService.CheckPassword:output_type -> zytell3301.UsersService.LoginResponse
+	50, // 91: zytell3301.UsersService.UsersService.RequestPasswordRecovery:output_type -> zytell3301.UsersService.RequestPasswordRecoveryResponse
+	42, // 92: zytell3301.UsersService.UsersService.RecoverPassword:output_type -> zytell3301.UsersService.LoginResponse
+	56, // 93: zytell3301.UsersService.UsersService.RequestSignupSecurityCode:output_type -> zytell3301.UsersService.RequestSecurityCodeResponse
+	56, // 94: zytell3301.UsersService.UsersService.RequestLoginSecurityCode:output_type -> zytell3301.UsersService.RequestSecurityCodeResponse
+	68, // 95: zytell3301.UsersService.UsersService.VerifySecurityCode:output_type -> zytell3301.error.Error
+	2,  // 96: zytell3301.UsersService.UsersService.GetUserByUsername:output_type -> zytell3301.UsersService.GetUserByUsernameResponse
+	4,  // 97: zytell3301.UsersService.UsersService.GetUserById:output_type -> zytell3301.UsersService.GetUserResponse
+	7,  // 98: zytell3301.UsersService.UsersService.GetUsersByIds:output_type -> zytell3301.UsersService.GetUsersByIdsResponse
+	9,  // 99: zytell3301.UsersService.UsersService.SearchUsers:output_type -> zytell3301.UsersService.SearchUsersResponse
+	4,  // 100: zytell3301.UsersService.UsersService.GetUserByPhone:output_type -> zytell3301.UsersService.GetUserResponse
+	68, // 101: zytell3301.UsersService.UsersService.Logout:output_type -> zytell3301.error.Error
+	68, // 102: zytell3301.UsersService.UsersService.RevokeCertificate:output_type -> zytell3301.error.Error
+	60, // 103: zytell3301.UsersService.UsersService.IsCertificateRevoked:output_type -> zytell3301.UsersService.IsCertificateRevokedResponse
+	62, // 104: zytell3301.UsersService.UsersService.GetCertificateRevocationList:output_type -> zytell3301.UsersService.GetCertificateRevocationListResponse
+	65, // 105: zytell3301.UsersService.UsersService.ListSessions:output_type -> zytell3301.UsersService.ListSessionsResponse
+	68, // 106: zytell3301.UsersService.UsersService.TerminateSession:output_type -> zytell3301.error.Error
+	68, // 107: zytell3301.UsersService.UsersService.TerminateAllOtherSessions:output_type -> zytell3301.error.Error
+	68, // 108: zytell3301.UsersService.UsersService.UpdateProfile:output_type -> zytell3301.error.Error
+	56, // 109: zytell3301.UsersService.UsersService.RequestChangePhoneSecurityCode:output_type -> zytell3301.UsersService.RequestSecurityCodeResponse
+	68, // 110: zytell3301.UsersService.UsersService.ChangePhone:output_type -> zytell3301.error.Error
+	56, // 111: zytell3301.UsersService.UsersService.RequestDeleteAccountSecurityCode:output_type -> zytell3301.UsersService.RequestSecurityCodeResponse
+	68, // 112: zytell3301.UsersService.UsersService.CancelAccountDeletion:output_type -> zytell3301.error.Error
+	68, // 113: zytell3301.UsersService.UsersService.SetAccountTTL:output_type -> zytell3301.error.Error
+	35, // 114: zytell3301.UsersService.UsersService.GetAccountTTL:output_type -> zytell3301.UsersService.GetAccountTTLResponse
+	68, // 115: zytell3301.UsersService.UsersService.SetPassword:output_type -> zytell3301.error.Error
+	68, // 116: zytell3301.UsersService.UsersService.ChangePassword:output_type -> zytell3301.error.Error
+	68, // 117: zytell3301.UsersService.UsersService.DisablePassword:output_type -> zytell3301.error.Error
+	56, // 118: zytell3301.UsersService.UsersService.RequestRecoveryEmailCode:output_type -> zytell3301.UsersService.RequestSecurityCodeResponse
+	68, // 119: zytell3301.UsersService.UsersService.VerifyRecoveryEmail:output_type -> zytell3301.error.Error
+	68, // 120: zytell3301.UsersService.UsersService.SetOnline:output_type -> zytell3301.error.Error
+	68, // 121: zytell3301.UsersService.UsersService.SetOffline:output_type -> zytell3301.error.Error
+	68, // 122: zytell3301.UsersService.UsersService.Heartbeat:output_type -> zytell3301.error.Error
+	68, // 123: zytell3301.UsersService.UsersService.SetPrivacyRule:output_type -> zytell3301.error.Error
+	22, // 124: zytell3301.UsersService.UsersService.GetPrivacyRules:output_type -> zytell3301.UsersService.GetPrivacyRulesResponse
+	26, // 125: zytell3301.UsersService.UsersService.ImportContacts:output_type -> zytell3301.UsersService.ImportContactsResponse
+	28, // 126: zytell3301.UsersService.UsersService.GetContacts:output_type -> zytell3301.UsersService.GetContactsResponse
+	68, // 127: zytell3301.UsersService.UsersService.DeleteContacts:output_type -> zytell3301.error.Error
+	31, // 128: zytell3301.UsersService.UsersService.GetContactsHash:output_type -> zytell3301.UsersService.GetContactsHashResponse
+	34, // 129: zytell3301.UsersService.UsersService.SubscribePresence:output_type -> zytell3301.UsersService.SubscribePresenceResponse
+	68, // 130: zytell3301.UsersService.UsersService.AdminDeleteUser:output_type -> zytell3301.error.Error
+	68, // 131: zytell3301.UsersService.UsersService.AdminUpdateUsername:output_type -> zytell3301.error.Error
+	86, // [86:132] is the sub-list for method output_type
+	40, // [40:86] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_api_pb_UsersService_users_service_proto_init() }
+func file_api_pb_UsersService_users_service_proto_init() {
+	if File_api_pb_UsersService_users_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_pb_UsersService_users_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUsernameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUsernameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserLookupResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDeleteAccountSecurityCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAccountDeletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountTTLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTTLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOnlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOfflineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPrivacyRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivacyRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivacyRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportedContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportContactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportContactsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePresenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePresenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -802,8 +5124,8 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByUsernameResponse); i {
+		file_api_pb_UsersService_users_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountTTLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -814,7 +5136,43 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUsernameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePhoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateUsernameMessage); i {
 			case 0:
 				return &v.state
@@ -826,7 +5184,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginRequest); i {
 			case 0:
 				return &v.state
@@ -838,7 +5196,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifySecurityCodeRequest); i {
 			case 0:
 				return &v.state
@@ -850,7 +5208,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginResponse); i {
 			case 0:
 				return &v.state
@@ -862,7 +5220,115 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisablePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRecoveryEmailCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRecoveryEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordRecoveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordRecoveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NewUserMessage); i {
 			case 0:
 				return &v.state
@@ -874,7 +5340,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Phone); i {
 			case 0:
 				return &v.state
@@ -886,7 +5352,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -898,7 +5364,7 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_UsersService_users_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_UsersService_users_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecurityCode); i {
 			case 0:
 				return &v.state
@@ -910,19 +5376,164 @@ func file_api_pb_UsersService_users_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSecurityCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsCertificateRevokedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsCertificateRevokedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCertificateRevocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCertificateRevocationListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_UsersService_users_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateAllOtherSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_pb_UsersService_users_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_pb_UsersService_users_service_proto_goTypes,
 		DependencyIndexes: file_api_pb_UsersService_users_service_proto_depIdxs,
+		EnumInfos:         file_api_pb_UsersService_users_service_proto_enumTypes,
 		MessageInfos:      file_api_pb_UsersService_users_service_proto_msgTypes,
 	}.Build()
 	File_api_pb_UsersService_users_service_proto = out.File
@@ -20,13 +20,88 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UsersServiceClient interface {
 	NewUser(ctx context.Context, in *NewUserMessage, opts ...grpc.CallOption) (*error1.Error, error)
-	DeleteUser(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error)
-	UpdateUsername(ctx context.Context, in *UpdateUsernameMessage, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	UpdateUsername(ctx context.Context, in *UpdateUsernameRequest, opts ...grpc.CallOption) (*error1.Error, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	RequestSignupSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error)
-	RequestLoginSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error)
+	// Completes a login that requires the password of two-step verification
+	CheckPassword(ctx context.Context, in *CheckPasswordRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Sends a recovery code to the verified recovery email of the user of a login that requires the password
+	RequestPasswordRecovery(ctx context.Context, in *RequestPasswordRecoveryRequest, opts ...grpc.CallOption) (*RequestPasswordRecoveryResponse, error)
+	// Resets the password by the recovery code, terminates all sessions of the user and completes the login
+	RecoverPassword(ctx context.Context, in *RecoverPasswordRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RequestSignupSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error)
+	RequestLoginSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error)
 	VerifySecurityCode(ctx context.Context, in *VerifySecurityCodeRequest, opts ...grpc.CallOption) (*error1.Error, error)
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserByUsernameResponse, error)
+	GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Returns one result per requested id in the same order. Missing users are marked as not found
+	GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
+	// Searches users by username prefix and by prefixes of the words of name and lastname. Only available to users
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	// Users that do not let the caller find them by phone are not found. Anonymous callers can not find any user
+	GetUserByPhone(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Revokes the client certificate of the caller and terminates its session
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Only available to services with an admin client certificate
+	RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	IsCertificateRevoked(ctx context.Context, in *IsCertificateRevokedRequest, opts ...grpc.CallOption) (*IsCertificateRevokedResponse, error)
+	GetCertificateRevocationList(ctx context.Context, in *GetCertificateRevocationListRequest, opts ...grpc.CallOption) (*GetCertificateRevocationListResponse, error)
+	// Returns sessions of the user identified by the client certificate of the caller
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Terminates sessions of the user identified by the client certificate of the caller except the session of that certificate
+	TerminateAllOtherSessions(ctx context.Context, in *TerminateAllOtherSessionsRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Sends security codes to the new and the current phone of the user identified by the client certificate of the caller
+	RequestChangePhoneSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	ChangePhone(ctx context.Context, in *ChangePhoneRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Sends a security code for account deletion to the phone of the user identified by the client certificate of the caller
+	RequestDeleteAccountSecurityCode(ctx context.Context, in *RequestDeleteAccountSecurityCodeRequest, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetAccountTTL(ctx context.Context, in *SetAccountTTLRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	GetAccountTTL(ctx context.Context, in *GetAccountTTLRequest, opts ...grpc.CallOption) (*GetAccountTTLResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	DisablePassword(ctx context.Context, in *DisablePasswordRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	RequestRecoveryEmailCode(ctx context.Context, in *RequestRecoveryEmailCodeRequest, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	VerifyRecoveryEmail(ctx context.Context, in *VerifyRecoveryEmailRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetOnline(ctx context.Context, in *SetOnlineRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetOffline(ctx context.Context, in *SetOfflineRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Keeps the user identified by the client certificate of the caller online. It must be sent more frequently than presence timeout
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetPrivacyRule(ctx context.Context, in *SetPrivacyRuleRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Returns all privacy rules of the user identified by the client certificate of the caller
+	GetPrivacyRules(ctx context.Context, in *GetPrivacyRulesRequest, opts ...grpc.CallOption) (*GetPrivacyRulesResponse, error)
+	// Imports contacts of the user identified by the client certificate of the caller. Contacts are matched to users by phone
+	ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (*ImportContactsResponse, error)
+	// Returns contacts of the user identified by the client certificate of the caller ordered by user id
+	GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	DeleteContacts(ctx context.Context, in *DeleteContactsRequest, opts ...grpc.CallOption) (*error1.Error, error)
+	// Returns the hash of GetContacts so that clients can skip fetching unchanged contacts
+	GetContactsHash(ctx context.Context, in *GetContactsHashRequest, opts ...grpc.CallOption) (*GetContactsHashResponse, error)
+	// Streams presence updates of the users. Current presence of the users is sent first. Updates of a user
+	// that are not sent yet are replaced by its newer updates, so slow subscribers only receive the latest presence
+	SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (UsersService_SubscribePresenceClient, error)
+	// Admin rpcs are only available to services with an admin client certificate
+	AdminDeleteUser(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error)
+	AdminUpdateUsername(ctx context.Context, in *UpdateUsernameMessage, opts ...grpc.CallOption) (*error1.Error, error)
 }
 
 type usersServiceClient struct {
@@ -46,8 +121,8 @@ func (c *usersServiceClient) NewUser(ctx context.Context, in *NewUserMessage, op
 	return out, nil
 }
 
-func (c *usersServiceClient) DeleteUser(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error) {
-	out := new(error1.Error)
+func (c *usersServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
 	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/DeleteUser", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -55,256 +130,1497 @@ func (c *usersServiceClient) DeleteUser(ctx context.Context, in *Phone, opts ...
 	return out, nil
 }
 
-func (c *usersServiceClient) UpdateUsername(ctx context.Context, in *UpdateUsernameMessage, opts ...grpc.CallOption) (*error1.Error, error) {
-	out := new(error1.Error)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/UpdateUsername", in, out, opts...)
-	if err != nil {
+func (c *usersServiceClient) UpdateUsername(ctx context.Context, in *UpdateUsernameRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/UpdateUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) CheckPassword(ctx context.Context, in *CheckPasswordRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/CheckPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestPasswordRecovery(ctx context.Context, in *RequestPasswordRecoveryRequest, opts ...grpc.CallOption) (*RequestPasswordRecoveryResponse, error) {
+	out := new(RequestPasswordRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestPasswordRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RecoverPassword(ctx context.Context, in *RecoverPasswordRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RecoverPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestSignupSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error) {
+	out := new(RequestSecurityCodeResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestSignupSecurityCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestLoginSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error) {
+	out := new(RequestSecurityCodeResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestLoginSecurityCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) VerifySecurityCode(ctx context.Context, in *VerifySecurityCodeRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/VerifySecurityCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserByUsernameResponse, error) {
+	out := new(GetUserByUsernameResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetUserByUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetUserById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error) {
+	out := new(GetUsersByIdsResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetUsersByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUserByPhone(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetUserByPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RevokeCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) IsCertificateRevoked(ctx context.Context, in *IsCertificateRevokedRequest, opts ...grpc.CallOption) (*IsCertificateRevokedResponse, error) {
+	out := new(IsCertificateRevokedResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/IsCertificateRevoked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetCertificateRevocationList(ctx context.Context, in *GetCertificateRevocationListRequest, opts ...grpc.CallOption) (*GetCertificateRevocationListResponse, error) {
+	out := new(GetCertificateRevocationListResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetCertificateRevocationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/TerminateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) TerminateAllOtherSessions(ctx context.Context, in *TerminateAllOtherSessionsRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/TerminateAllOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestChangePhoneSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error) {
+	out := new(RequestSecurityCodeResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestChangePhoneSecurityCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ChangePhone(ctx context.Context, in *ChangePhoneRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/ChangePhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestDeleteAccountSecurityCode(ctx context.Context, in *RequestDeleteAccountSecurityCodeRequest, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error) {
+	out := new(RequestSecurityCodeResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestDeleteAccountSecurityCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/CancelAccountDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SetAccountTTL(ctx context.Context, in *SetAccountTTLRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SetAccountTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetAccountTTL(ctx context.Context, in *GetAccountTTLRequest, opts ...grpc.CallOption) (*GetAccountTTLResponse, error) {
+	out := new(GetAccountTTLResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetAccountTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) DisablePassword(ctx context.Context, in *DisablePasswordRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/DisablePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestRecoveryEmailCode(ctx context.Context, in *RequestRecoveryEmailCodeRequest, opts ...grpc.CallOption) (*RequestSecurityCodeResponse, error) {
+	out := new(RequestSecurityCodeResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestRecoveryEmailCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) VerifyRecoveryEmail(ctx context.Context, in *VerifyRecoveryEmailRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/VerifyRecoveryEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SetOnline(ctx context.Context, in *SetOnlineRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SetOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SetOffline(ctx context.Context, in *SetOfflineRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SetOffline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SetPrivacyRule(ctx context.Context, in *SetPrivacyRuleRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/SetPrivacyRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetPrivacyRules(ctx context.Context, in *GetPrivacyRulesRequest, opts ...grpc.CallOption) (*GetPrivacyRulesResponse, error) {
+	out := new(GetPrivacyRulesResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetPrivacyRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (*ImportContactsResponse, error) {
+	out := new(ImportContactsResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/ImportContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetContacts(ctx context.Context, in *GetContactsRequest, opts ...grpc.CallOption) (*GetContactsResponse, error) {
+	out := new(GetContactsResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) DeleteContacts(ctx context.Context, in *DeleteContactsRequest, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/DeleteContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetContactsHash(ctx context.Context, in *GetContactsHashRequest, opts ...grpc.CallOption) (*GetContactsHashResponse, error) {
+	out := new(GetContactsHashResponse)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetContactsHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (UsersService_SubscribePresenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UsersService_ServiceDesc.Streams[0], "/zytell3301.UsersService.UsersService/SubscribePresence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &usersServiceSubscribePresenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UsersService_SubscribePresenceClient interface {
+	Recv() (*SubscribePresenceResponse, error)
+	grpc.ClientStream
+}
+
+type usersServiceSubscribePresenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *usersServiceSubscribePresenceClient) Recv() (*SubscribePresenceResponse, error) {
+	m := new(SubscribePresenceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *usersServiceClient) AdminDeleteUser(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/AdminDeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) AdminUpdateUsername(ctx context.Context, in *UpdateUsernameMessage, opts ...grpc.CallOption) (*error1.Error, error) {
+	out := new(error1.Error)
+	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/AdminUpdateUsername", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersServiceServer is the server API for UsersService service.
+// All implementations must embed UnimplementedUsersServiceServer
+// for forward compatibility
+type UsersServiceServer interface {
+	NewUser(context.Context, *NewUserMessage) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	UpdateUsername(context.Context, *UpdateUsernameRequest) (*error1.Error, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Completes a login that requires the password of two-step verification
+	CheckPassword(context.Context, *CheckPasswordRequest) (*LoginResponse, error)
+	// Sends a recovery code to the verified recovery email of the user of a login that requires the password
+	RequestPasswordRecovery(context.Context, *RequestPasswordRecoveryRequest) (*RequestPasswordRecoveryResponse, error)
+	// Resets the password by the recovery code, terminates all sessions of the user and completes the login
+	RecoverPassword(context.Context, *RecoverPasswordRequest) (*LoginResponse, error)
+	RequestSignupSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error)
+	RequestLoginSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error)
+	VerifySecurityCode(context.Context, *VerifySecurityCodeRequest) (*error1.Error, error)
+	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error)
+	GetUserById(context.Context, *GetUserByIdRequest) (*GetUserResponse, error)
+	// Returns one result per requested id in the same order. Missing users are marked as not found
+	GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error)
+	// Searches users by username prefix and by prefixes of the words of name and lastname. Only available to users
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// Users that do not let the caller find them by phone are not found. Anonymous callers can not find any user
+	GetUserByPhone(context.Context, *Phone) (*GetUserResponse, error)
+	// Revokes the client certificate of the caller and terminates its session
+	Logout(context.Context, *LogoutRequest) (*error1.Error, error)
+	// Only available to services with an admin client certificate
+	RevokeCertificate(context.Context, *RevokeCertificateRequest) (*error1.Error, error)
+	IsCertificateRevoked(context.Context, *IsCertificateRevokedRequest) (*IsCertificateRevokedResponse, error)
+	GetCertificateRevocationList(context.Context, *GetCertificateRevocationListRequest) (*GetCertificateRevocationListResponse, error)
+	// Returns sessions of the user identified by the client certificate of the caller
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	TerminateSession(context.Context, *TerminateSessionRequest) (*error1.Error, error)
+	// Terminates sessions of the user identified by the client certificate of the caller except the session of that certificate
+	TerminateAllOtherSessions(context.Context, *TerminateAllOtherSessionsRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*error1.Error, error)
+	// Sends security codes to the new and the current phone of the user identified by the client certificate of the caller
+	RequestChangePhoneSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	ChangePhone(context.Context, *ChangePhoneRequest) (*error1.Error, error)
+	// Sends a security code for account deletion to the phone of the user identified by the client certificate of the caller
+	RequestDeleteAccountSecurityCode(context.Context, *RequestDeleteAccountSecurityCodeRequest) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetAccountTTL(context.Context, *SetAccountTTLRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	GetAccountTTL(context.Context, *GetAccountTTLRequest) (*GetAccountTTLResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetPassword(context.Context, *SetPasswordRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	ChangePassword(context.Context, *ChangePasswordRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	DisablePassword(context.Context, *DisablePasswordRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	RequestRecoveryEmailCode(context.Context, *RequestRecoveryEmailCodeRequest) (*RequestSecurityCodeResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	VerifyRecoveryEmail(context.Context, *VerifyRecoveryEmailRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetOnline(context.Context, *SetOnlineRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetOffline(context.Context, *SetOfflineRequest) (*error1.Error, error)
+	// Keeps the user identified by the client certificate of the caller online. It must be sent more frequently than presence timeout
+	Heartbeat(context.Context, *HeartbeatRequest) (*error1.Error, error)
+	// Acts on the user identified by the client certificate of the caller
+	SetPrivacyRule(context.Context, *SetPrivacyRuleRequest) (*error1.Error, error)
+	// Returns all privacy rules of the user identified by the client certificate of the caller
+	GetPrivacyRules(context.Context, *GetPrivacyRulesRequest) (*GetPrivacyRulesResponse, error)
+	// Imports contacts of the user identified by the client certificate of the caller. Contacts are matched to users by phone
+	ImportContacts(context.Context, *ImportContactsRequest) (*ImportContactsResponse, error)
+	// Returns contacts of the user identified by the client certificate of the caller ordered by user id
+	GetContacts(context.Context, *GetContactsRequest) (*GetContactsResponse, error)
+	// Acts on the user identified by the client certificate of the caller
+	DeleteContacts(context.Context, *DeleteContactsRequest) (*error1.Error, error)
+	// Returns the hash of GetContacts so that clients can skip fetching unchanged contacts
+	GetContactsHash(context.Context, *GetContactsHashRequest) (*GetContactsHashResponse, error)
+	// Streams presence updates of the users. Current presence of the users is sent first. Updates of a user
+	// that are not sent yet are replaced by its newer updates, so slow subscribers only receive the latest presence
+	SubscribePresence(*SubscribePresenceRequest, UsersService_SubscribePresenceServer) error
+	// Admin rpcs are only available to services with an admin client certificate
+	AdminDeleteUser(context.Context, *Phone) (*error1.Error, error)
+	AdminUpdateUsername(context.Context, *UpdateUsernameMessage) (*error1.Error, error)
+	mustEmbedUnimplementedUsersServiceServer()
+}
+
+// UnimplementedUsersServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUsersServiceServer struct {
+}
+
+func (UnimplementedUsersServiceServer) NewUser(context.Context, *NewUserMessage) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewUser not implemented")
+}
+func (UnimplementedUsersServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUsersServiceServer) UpdateUsername(context.Context, *UpdateUsernameRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsername not implemented")
+}
+func (UnimplementedUsersServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUsersServiceServer) CheckPassword(context.Context, *CheckPasswordRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPassword not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestPasswordRecovery(context.Context, *RequestPasswordRecoveryRequest) (*RequestPasswordRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordRecovery not implemented")
+}
+func (UnimplementedUsersServiceServer) RecoverPassword(context.Context, *RecoverPasswordRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPassword not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestSignupSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSignupSecurityCode not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestLoginSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLoginSecurityCode not implemented")
+}
+func (UnimplementedUsersServiceServer) VerifySecurityCode(context.Context, *VerifySecurityCodeRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecurityCode not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUserById(context.Context, *GetUserByIdRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
+func (UnimplementedUsersServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUsersServiceServer) GetUserByPhone(context.Context, *Phone) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByPhone not implemented")
+}
+func (UnimplementedUsersServiceServer) Logout(context.Context, *LogoutRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUsersServiceServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedUsersServiceServer) IsCertificateRevoked(context.Context, *IsCertificateRevokedRequest) (*IsCertificateRevokedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCertificateRevoked not implemented")
+}
+func (UnimplementedUsersServiceServer) GetCertificateRevocationList(context.Context, *GetCertificateRevocationListRequest) (*GetCertificateRevocationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateRevocationList not implemented")
+}
+func (UnimplementedUsersServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUsersServiceServer) TerminateSession(context.Context, *TerminateSessionRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSession not implemented")
+}
+func (UnimplementedUsersServiceServer) TerminateAllOtherSessions(context.Context, *TerminateAllOtherSessionsRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateAllOtherSessions not implemented")
+}
+func (UnimplementedUsersServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestChangePhoneSecurityCode(context.Context, *Phone) (*RequestSecurityCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestChangePhoneSecurityCode not implemented")
+}
+func (UnimplementedUsersServiceServer) ChangePhone(context.Context, *ChangePhoneRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePhone not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestDeleteAccountSecurityCode(context.Context, *RequestDeleteAccountSecurityCodeRequest) (*RequestSecurityCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDeleteAccountSecurityCode not implemented")
+}
+func (UnimplementedUsersServiceServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedUsersServiceServer) SetAccountTTL(context.Context, *SetAccountTTLRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountTTL not implemented")
+}
+func (UnimplementedUsersServiceServer) GetAccountTTL(context.Context, *GetAccountTTLRequest) (*GetAccountTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTTL not implemented")
+}
+func (UnimplementedUsersServiceServer) SetPassword(context.Context, *SetPasswordRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPassword not implemented")
+}
+func (UnimplementedUsersServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUsersServiceServer) DisablePassword(context.Context, *DisablePasswordRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePassword not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestRecoveryEmailCode(context.Context, *RequestRecoveryEmailCodeRequest) (*RequestSecurityCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRecoveryEmailCode not implemented")
+}
+func (UnimplementedUsersServiceServer) VerifyRecoveryEmail(context.Context, *VerifyRecoveryEmailRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecoveryEmail not implemented")
+}
+func (UnimplementedUsersServiceServer) SetOnline(context.Context, *SetOnlineRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnline not implemented")
+}
+func (UnimplementedUsersServiceServer) SetOffline(context.Context, *SetOfflineRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOffline not implemented")
+}
+func (UnimplementedUsersServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedUsersServiceServer) SetPrivacyRule(context.Context, *SetPrivacyRuleRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrivacyRule not implemented")
+}
+func (UnimplementedUsersServiceServer) GetPrivacyRules(context.Context, *GetPrivacyRulesRequest) (*GetPrivacyRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacyRules not implemented")
+}
+func (UnimplementedUsersServiceServer) ImportContacts(context.Context, *ImportContactsRequest) (*ImportContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportContacts not implemented")
+}
+func (UnimplementedUsersServiceServer) GetContacts(context.Context, *GetContactsRequest) (*GetContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContacts not implemented")
+}
+func (UnimplementedUsersServiceServer) DeleteContacts(context.Context, *DeleteContactsRequest) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContacts not implemented")
+}
+func (UnimplementedUsersServiceServer) GetContactsHash(context.Context, *GetContactsHashRequest) (*GetContactsHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactsHash not implemented")
+}
+func (UnimplementedUsersServiceServer) SubscribePresence(*SubscribePresenceRequest, UsersService_SubscribePresenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (UnimplementedUsersServiceServer) AdminDeleteUser(context.Context, *Phone) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteUser not implemented")
+}
+func (UnimplementedUsersServiceServer) AdminUpdateUsername(context.Context, *UpdateUsernameMessage) (*error1.Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateUsername not implemented")
+}
+func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
+
+// UnsafeUsersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsersServiceServer will
+// result in compilation errors.
+type UnsafeUsersServiceServer interface {
+	mustEmbedUnimplementedUsersServiceServer()
+}
+
+func RegisterUsersServiceServer(s grpc.ServiceRegistrar, srv UsersServiceServer) {
+	s.RegisterService(&UsersService_ServiceDesc, srv)
+}
+
+func _UsersService_NewUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewUserMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).NewUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/NewUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).NewUser(ctx, req.(*NewUserMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_UpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).UpdateUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/UpdateUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).UpdateUsername(ctx, req.(*UpdateUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_CheckPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).CheckPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/CheckPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).CheckPassword(ctx, req.(*CheckPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestPasswordRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestPasswordRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestPasswordRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestPasswordRecovery(ctx, req.(*RequestPasswordRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RecoverPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RecoverPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RecoverPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RecoverPassword(ctx, req.(*RecoverPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestSignupSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Phone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestSignupSecurityCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestSignupSecurityCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestSignupSecurityCode(ctx, req.(*Phone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestLoginSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Phone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestLoginSecurityCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestLoginSecurityCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestLoginSecurityCode(ctx, req.(*Phone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_VerifySecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySecurityCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).VerifySecurityCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/VerifySecurityCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).VerifySecurityCode(ctx, req.(*VerifySecurityCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetUserByUsername",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByUsername(ctx, req.(*GetUserByUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetUserById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserById(ctx, req.(*GetUserByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetUsersByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUsersByIds(ctx, req.(*GetUsersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/SearchUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetUserByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Phone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetUserByPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByPhone(ctx, req.(*Phone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RevokeCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_IsCertificateRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsCertificateRevokedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).IsCertificateRevoked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/IsCertificateRevoked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).IsCertificateRevoked(ctx, req.(*IsCertificateRevokedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetCertificateRevocationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateRevocationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetCertificateRevocationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetCertificateRevocationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetCertificateRevocationList(ctx, req.(*GetCertificateRevocationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_TerminateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).TerminateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/TerminateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).TerminateSession(ctx, req.(*TerminateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_TerminateAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateAllOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).TerminateAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/TerminateAllOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).TerminateAllOtherSessions(ctx, req.(*TerminateAllOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestChangePhoneSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Phone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestChangePhoneSecurityCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestChangePhoneSecurityCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestChangePhoneSecurityCode(ctx, req.(*Phone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ChangePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ChangePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/ChangePhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ChangePhone(ctx, req.(*ChangePhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestDeleteAccountSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeleteAccountSecurityCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestDeleteAccountSecurityCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestDeleteAccountSecurityCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestDeleteAccountSecurityCode(ctx, req.(*RequestDeleteAccountSecurityCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/CancelAccountDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_SetAccountTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SetAccountTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/SetAccountTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SetAccountTTL(ctx, req.(*SetAccountTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_GetAccountTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetAccountTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/GetAccountTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetAccountTTL(ctx, req.(*GetAccountTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_SetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/SetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SetPassword(ctx, req.(*SetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_DisablePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePasswordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServiceServer).DisablePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/DisablePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).DisablePassword(ctx, req.(*DisablePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/Login", in, out, opts...)
-	if err != nil {
+func _UsersService_RequestRecoveryEmailCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRecoveryEmailCodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestRecoveryEmailCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/RequestRecoveryEmailCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestRecoveryEmailCode(ctx, req.(*RequestRecoveryEmailCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersServiceClient) RequestSignupSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error) {
-	out := new(error1.Error)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestSignupSecurityCode", in, out, opts...)
-	if err != nil {
+func _UsersService_VerifyRecoveryEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRecoveryEmailRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServiceServer).VerifyRecoveryEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/VerifyRecoveryEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).VerifyRecoveryEmail(ctx, req.(*VerifyRecoveryEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersServiceClient) RequestLoginSecurityCode(ctx context.Context, in *Phone, opts ...grpc.CallOption) (*error1.Error, error) {
-	out := new(error1.Error)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/RequestLoginSecurityCode", in, out, opts...)
-	if err != nil {
+func _UsersService_SetOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOnlineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SetOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/SetOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SetOnline(ctx, req.(*SetOnlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersServiceClient) VerifySecurityCode(ctx context.Context, in *VerifySecurityCodeRequest, opts ...grpc.CallOption) (*error1.Error, error) {
-	out := new(error1.Error)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/VerifySecurityCode", in, out, opts...)
-	if err != nil {
+func _UsersService_SetOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOfflineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SetOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/SetOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SetOffline(ctx, req.(*SetOfflineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersServiceClient) GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserByUsernameResponse, error) {
-	out := new(GetUserByUsernameResponse)
-	err := c.cc.Invoke(ctx, "/zytell3301.UsersService.UsersService/GetUserByUsername", in, out, opts...)
-	if err != nil {
+func _UsersService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// UsersServiceServer is the server API for UsersService service.
-// All implementations must embed UnimplementedUsersServiceServer
-// for forward compatibility
-type UsersServiceServer interface {
-	NewUser(context.Context, *NewUserMessage) (*error1.Error, error)
-	DeleteUser(context.Context, *Phone) (*error1.Error, error)
-	UpdateUsername(context.Context, *UpdateUsernameMessage) (*error1.Error, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	RequestSignupSecurityCode(context.Context, *Phone) (*error1.Error, error)
-	RequestLoginSecurityCode(context.Context, *Phone) (*error1.Error, error)
-	VerifySecurityCode(context.Context, *VerifySecurityCodeRequest) (*error1.Error, error)
-	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error)
-	mustEmbedUnimplementedUsersServiceServer()
-}
-
-// UnimplementedUsersServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedUsersServiceServer struct {
-}
-
-func (UnimplementedUsersServiceServer) NewUser(context.Context, *NewUserMessage) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewUser not implemented")
-}
-func (UnimplementedUsersServiceServer) DeleteUser(context.Context, *Phone) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
-}
-func (UnimplementedUsersServiceServer) UpdateUsername(context.Context, *UpdateUsernameMessage) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsername not implemented")
-}
-func (UnimplementedUsersServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (UnimplementedUsersServiceServer) RequestSignupSecurityCode(context.Context, *Phone) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestSignupSecurityCode not implemented")
-}
-func (UnimplementedUsersServiceServer) RequestLoginSecurityCode(context.Context, *Phone) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestLoginSecurityCode not implemented")
-}
-func (UnimplementedUsersServiceServer) VerifySecurityCode(context.Context, *VerifySecurityCodeRequest) (*error1.Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifySecurityCode not implemented")
-}
-func (UnimplementedUsersServiceServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserByUsernameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
-}
-func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
-
-// UnsafeUsersServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UsersServiceServer will
-// result in compilation errors.
-type UnsafeUsersServiceServer interface {
-	mustEmbedUnimplementedUsersServiceServer()
-}
-
-func RegisterUsersServiceServer(s grpc.ServiceRegistrar, srv UsersServiceServer) {
-	s.RegisterService(&UsersService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UsersServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zytell3301.UsersService.UsersService/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_NewUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewUserMessage)
+func _UsersService_SetPrivacyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrivacyRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).NewUser(ctx, in)
+		return srv.(UsersServiceServer).SetPrivacyRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/NewUser",
+		FullMethod: "/zytell3301.UsersService.UsersService/SetPrivacyRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).NewUser(ctx, req.(*NewUserMessage))
+		return srv.(UsersServiceServer).SetPrivacyRule(ctx, req.(*SetPrivacyRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Phone)
+func _UsersService_GetPrivacyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacyRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).DeleteUser(ctx, in)
+		return srv.(UsersServiceServer).GetPrivacyRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/DeleteUser",
+		FullMethod: "/zytell3301.UsersService.UsersService/GetPrivacyRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).DeleteUser(ctx, req.(*Phone))
+		return srv.(UsersServiceServer).GetPrivacyRules(ctx, req.(*GetPrivacyRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_UpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUsernameMessage)
+func _UsersService_ImportContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).UpdateUsername(ctx, in)
+		return srv.(UsersServiceServer).ImportContacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/UpdateUsername",
+		FullMethod: "/zytell3301.UsersService.UsersService/ImportContacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).UpdateUsername(ctx, req.(*UpdateUsernameMessage))
+		return srv.(UsersServiceServer).ImportContacts(ctx, req.(*ImportContactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _UsersService_GetContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).Login(ctx, in)
+		return srv.(UsersServiceServer).GetContacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/Login",
+		FullMethod: "/zytell3301.UsersService.UsersService/GetContacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UsersServiceServer).GetContacts(ctx, req.(*GetContactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_RequestSignupSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Phone)
+func _UsersService_DeleteContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).RequestSignupSecurityCode(ctx, in)
+		return srv.(UsersServiceServer).DeleteContacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/RequestSignupSecurityCode",
+		FullMethod: "/zytell3301.UsersService.UsersService/DeleteContacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).RequestSignupSecurityCode(ctx, req.(*Phone))
+		return srv.(UsersServiceServer).DeleteContacts(ctx, req.(*DeleteContactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_RequestLoginSecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Phone)
+func _UsersService_GetContactsHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactsHashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).RequestLoginSecurityCode(ctx, in)
+		return srv.(UsersServiceServer).GetContactsHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/RequestLoginSecurityCode",
+		FullMethod: "/zytell3301.UsersService.UsersService/GetContactsHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).RequestLoginSecurityCode(ctx, req.(*Phone))
+		return srv.(UsersServiceServer).GetContactsHash(ctx, req.(*GetContactsHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_VerifySecurityCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifySecurityCodeRequest)
+func _UsersService_SubscribePresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePresenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UsersServiceServer).SubscribePresence(m, &usersServiceSubscribePresenceServer{stream})
+}
+
+type UsersService_SubscribePresenceServer interface {
+	Send(*SubscribePresenceResponse) error
+	grpc.ServerStream
+}
+
+type usersServiceSubscribePresenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *usersServiceSubscribePresenceServer) Send(m *SubscribePresenceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UsersService_AdminDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Phone)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).VerifySecurityCode(ctx, in)
+		return srv.(UsersServiceServer).AdminDeleteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/VerifySecurityCode",
+		FullMethod: "/zytell3301.UsersService.UsersService/AdminDeleteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).VerifySecurityCode(ctx, req.(*VerifySecurityCodeRequest))
+		return srv.(UsersServiceServer).AdminDeleteUser(ctx, req.(*Phone))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UsersService_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByUsernameRequest)
+func _UsersService_AdminUpdateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUsernameMessage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServiceServer).GetUserByUsername(ctx, in)
+		return srv.(UsersServiceServer).AdminUpdateUsername(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zytell3301.UsersService.UsersService/GetUserByUsername",
+		FullMethod: "/zytell3301.UsersService.UsersService/AdminUpdateUsername",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).GetUserByUsername(ctx, req.(*GetUserByUsernameRequest))
+		return srv.(UsersServiceServer).AdminUpdateUsername(ctx, req.(*UpdateUsernameMessage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -332,6 +1648,18 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _UsersService_Login_Handler,
 		},
+		{
+			MethodName: "CheckPassword",
+			Handler:    _UsersService_CheckPassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordRecovery",
+			Handler:    _UsersService_RequestPasswordRecovery_Handler,
+		},
+		{
+			MethodName: "RecoverPassword",
+			Handler:    _UsersService_RecoverPassword_Handler,
+		},
 		{
 			MethodName: "RequestSignupSecurityCode",
 			Handler:    _UsersService_RequestSignupSecurityCode_Handler,
@@ -348,7 +1676,149 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByUsername",
 			Handler:    _UsersService_GetUserByUsername_Handler,
 		},
+		{
+			MethodName: "GetUserById",
+			Handler:    _UsersService_GetUserById_Handler,
+		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _UsersService_GetUsersByIds_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UsersService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetUserByPhone",
+			Handler:    _UsersService_GetUserByPhone_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _UsersService_Logout_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _UsersService_RevokeCertificate_Handler,
+		},
+		{
+			MethodName: "IsCertificateRevoked",
+			Handler:    _UsersService_IsCertificateRevoked_Handler,
+		},
+		{
+			MethodName: "GetCertificateRevocationList",
+			Handler:    _UsersService_GetCertificateRevocationList_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UsersService_ListSessions_Handler,
+		},
+		{
+			MethodName: "TerminateSession",
+			Handler:    _UsersService_TerminateSession_Handler,
+		},
+		{
+			MethodName: "TerminateAllOtherSessions",
+			Handler:    _UsersService_TerminateAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UsersService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "RequestChangePhoneSecurityCode",
+			Handler:    _UsersService_RequestChangePhoneSecurityCode_Handler,
+		},
+		{
+			MethodName: "ChangePhone",
+			Handler:    _UsersService_ChangePhone_Handler,
+		},
+		{
+			MethodName: "RequestDeleteAccountSecurityCode",
+			Handler:    _UsersService_RequestDeleteAccountSecurityCode_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _UsersService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "SetAccountTTL",
+			Handler:    _UsersService_SetAccountTTL_Handler,
+		},
+		{
+			MethodName: "GetAccountTTL",
+			Handler:    _UsersService_GetAccountTTL_Handler,
+		},
+		{
+			MethodName: "SetPassword",
+			Handler:    _UsersService_SetPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UsersService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DisablePassword",
+			Handler:    _UsersService_DisablePassword_Handler,
+		},
+		{
+			MethodName: "RequestRecoveryEmailCode",
+			Handler:    _UsersService_RequestRecoveryEmailCode_Handler,
+		},
+		{
+			MethodName: "VerifyRecoveryEmail",
+			Handler:    _UsersService_VerifyRecoveryEmail_Handler,
+		},
+		{
+			MethodName: "SetOnline",
+			Handler:    _UsersService_SetOnline_Handler,
+		},
+		{
+			MethodName: "SetOffline",
+			Handler:    _UsersService_SetOffline_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _UsersService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "SetPrivacyRule",
+			Handler:    _UsersService_SetPrivacyRule_Handler,
+		},
+		{
+			MethodName: "GetPrivacyRules",
+			Handler:    _UsersService_GetPrivacyRules_Handler,
+		},
+		{
+			MethodName: "ImportContacts",
+			Handler:    _UsersService_ImportContacts_Handler,
+		},
+		{
+			MethodName: "GetContacts",
+			Handler:    _UsersService_GetContacts_Handler,
+		},
+		{
+			MethodName: "DeleteContacts",
+			Handler:    _UsersService_DeleteContacts_Handler,
+		},
+		{
+			MethodName: "GetContactsHash",
+			Handler:    _UsersService_GetContactsHash_Handler,
+		},
+		{
+			MethodName: "AdminDeleteUser",
+			Handler:    _UsersService_AdminDeleteUser_Handler,
+		},
+		{
+			MethodName: "AdminUpdateUsername",
+			Handler:    _UsersService_AdminUpdateUsername_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePresence",
+			Handler:       _UsersService_SubscribePresence_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/UsersService/users-service.proto",
 }